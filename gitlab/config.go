@@ -3,8 +3,10 @@ package gitlab
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/xanzy/go-gitlab"
@@ -12,14 +14,59 @@ import (
 
 // Config is per-provider, specifies where to connect to gitlab
 type Config struct {
-	Token      string
-	BaseURL    string
-	Insecure   bool
-	CACertFile string
+	Token             string
+	OAuthToken        string
+	BaseURL           string
+	Insecure          bool
+	CACertFile        string
+	RequestTimeout    time.Duration
+	DisableKeepAlives bool
+	LogLevel          string
+
+	// RunnerMaximumTimeoutLimit is the largest maximum timeout, in seconds,
+	// accepted for a runner.
+	RunnerMaximumTimeoutLimit int
+
+	// minLogLevel is the index in logLevels of the least severe level
+	// logged, resolved from LogLevel.
+	minLogLevel int
+}
+
+// gitlabMeta is the provider meta handed to resources and data sources: the
+// client, along with the configuration it was built from, so that settings
+// are kept per provider instance.
+type gitlabMeta struct {
+	client *gitlab.Client
+	config *Config
+}
+
+// authMode returns the method the provider authenticates to gitlab with.
+func (c *Config) authMode() string {
+	if c.OAuthToken != "" {
+		return "oauth"
+	}
+	return "token"
+}
+
+// validateAuth checks exactly one authentication method is configured.
+func (c *Config) validateAuth() error {
+	if c.Token != "" && c.OAuthToken != "" {
+		return fmt.Errorf("only one of token and oauth_token can be set, " +
+			"check the GITLAB_TOKEN and GITLAB_OAUTH_TOKEN environment variables are not both set")
+	}
+	if c.Token == "" && c.OAuthToken == "" {
+		return fmt.Errorf("no GitLab credentials configured: set token or oauth_token in the provider block, " +
+			"or the GITLAB_TOKEN or GITLAB_OAUTH_TOKEN environment variable")
+	}
+	return nil
 }
 
 // Client returns a *gitlab.Client to interact with the configured gitlab instance
 func (c *Config) Client() (interface{}, error) {
+	if err := c.validateAuth(); err != nil {
+		return nil, err
+	}
+
 	// Configure TLS/SSL
 	tlsConfig := &tls.Config{}
 
@@ -41,14 +88,23 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	t := &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: tlsConfig,
+		Proxy:             http.ProxyFromEnvironment,
+		TLSClientConfig:   tlsConfig,
+		DisableKeepAlives: c.DisableKeepAlives,
 	}
 	transport := logging.NewTransport("GitLab", t)
 
-	httpClient := &http.Client{Transport: transport}
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   c.RequestTimeout,
+	}
 
-	client := gitlab.NewClient(httpClient, c.Token)
+	var client *gitlab.Client
+	if c.OAuthToken != "" {
+		client = gitlab.NewOAuthClient(httpClient, c.OAuthToken)
+	} else {
+		client = gitlab.NewClient(httpClient, c.Token)
+	}
 	if c.BaseURL != "" {
 		err := client.SetBaseURL(c.BaseURL)
 		if err != nil {
@@ -63,5 +119,5 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
-	return client, nil
+	return &gitlabMeta{client: client, config: c}, nil
 }
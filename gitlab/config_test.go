@@ -0,0 +1,119 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfig_subpath(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gitlab/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "username": "root"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Config{Token: "token", BaseURL: server.URL + "/gitlab/api/v4/"}
+	if _, err := c.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c = &Config{Token: "token", BaseURL: server.URL}
+	if _, err := c.Client(); err == nil {
+		t.Fatalf("expected an error when the subpath is missing")
+	}
+}
+
+func TestConfig_requestTimeout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "username": "root"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Config{Token: "token", BaseURL: server.URL, RequestTimeout: 50 * time.Millisecond, DisableKeepAlives: true}
+	_, err := c.Client()
+	if err == nil {
+		t.Fatalf("expected a timeout error")
+	}
+	if !strings.Contains(err.Error(), "Timeout") {
+		t.Fatalf("expected a timeout error, got: %s", err)
+	}
+
+	c.RequestTimeout = 5 * time.Second
+	if _, err := c.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestConfig_validateAuth(t *testing.T) {
+	cases := []struct {
+		Token      string
+		OAuthToken string
+		Error      bool
+	}{
+		{
+			Token: "token",
+		},
+		{
+			OAuthToken: "oauth",
+		},
+		{
+			Token:      "token",
+			OAuthToken: "oauth",
+			Error:      true,
+		},
+		{
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		c := &Config{Token: tc.Token, OAuthToken: tc.OAuthToken}
+		err := c.validateAuth()
+		if tc.Error && err == nil {
+			t.Fatalf("expected an error for token %q and oauth_token %q", tc.Token, tc.OAuthToken)
+		}
+		if !tc.Error && err != nil {
+			t.Fatalf("unexpected error for token %q and oauth_token %q: %s", tc.Token, tc.OAuthToken, err)
+		}
+	}
+}
+
+func TestConfig_oauthToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer oauth" {
+			http.Error(w, `{"message": "401 Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		if got := r.Header.Get("Private-Token"); got != "" {
+			http.Error(w, `{"message": "401 Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "username": "root"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Config{OAuthToken: "oauth", BaseURL: server.URL}
+	if _, err := c.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := c.authMode(); got != "oauth" {
+		t.Fatalf("got auth mode %q expected %q", got, "oauth")
+	}
+
+	c = &Config{Token: "oauth", BaseURL: server.URL}
+	if _, err := c.Client(); err == nil {
+		t.Fatalf("expected the private token to be rejected")
+	}
+}
@@ -0,0 +1,66 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabAllRunnerImportIDs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabAllRunnerImportIDsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"instance_type", "group_type", "project_type"}, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"active", "paused", "online", "offline"}, false),
+			},
+			"import_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabAllRunnerImportIDsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	options := &gitlab.ListRunnersOptions{}
+	if v, ok := d.GetOk("type"); ok {
+		options.Type = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("status"); ok {
+		options.Status = gitlab.String(v.(string))
+	}
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner import IDs %s", redactedJSON(options))
+
+	runners, err := listAllGitlabRunners(client, options)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(runners))
+	for _, runner := range runners {
+		ids = append(ids, strconv.Itoa(runner.ID))
+	}
+
+	d.Set("import_ids", ids)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join([]string{
+		d.Get("type").(string),
+		d.Get("status").(string),
+	}, ":"))))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabAllRunnerImportIDs_read(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/all", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "project_type" {
+			t.Errorf("got type %q expected project_type", got)
+		}
+		if got := r.URL.Query().Get("status"); got != "online" {
+			t.Errorf("got status %q expected online", got)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1}, {"id": 12}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id": 123}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabAllRunnerImportIDs().Schema, map[string]interface{}{
+		"type":   "project_type",
+		"status": "online",
+	})
+	if err := dataSourceGitlabAllRunnerImportIDsRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if got, want := d.Get("import_ids").([]interface{}), []interface{}{"1", "12", "123"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got import_ids %v expected %v", got, want)
+	}
+}
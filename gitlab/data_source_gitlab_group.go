@@ -68,7 +68,7 @@ func dataSourceGitlabGroup() *schema.Resource {
 }
 
 func dataSourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	var group *gitlab.Group
 	var err error
@@ -0,0 +1,141 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabInstanceRunners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabInstanceRunnersRead,
+		Schema: map[string]*schema.Schema{
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tag_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"online_within": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validatePositiveDurationFunc(),
+			},
+			"runners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"online": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"contacted_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabInstanceRunnersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	tagList := *stringSetToStringSlice(d.Get("tag_list").(*schema.Set))
+	paused, pausedOk := d.GetOkExists("paused")
+
+	var optionsHash strings.Builder
+	if pausedOk {
+		optionsHash.WriteString(strconv.FormatBool(paused.(bool)))
+	}
+	optionsHash.WriteString(",")
+	optionsHash.WriteString(strings.Join(tagList, ","))
+	optionsHash.WriteString(",")
+
+	var onlineWithin time.Duration
+	if v, ok := d.GetOk("online_within"); ok {
+		onlineWithin, _ = time.ParseDuration(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+
+	options := &gitlab.ListRunnersOptions{
+		Type: gitlab.String("instance_type"),
+	}
+
+	logRedacted(meta, "[INFO] Reading Gitlab instance runners %s", redactedJSON(options))
+
+	runners, err := listAllGitlabRunners(client, options)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	var matches []*gitlab.RunnerDetails
+	for _, runner := range runners {
+		if pausedOk && runner.Active == paused.(bool) {
+			continue
+		}
+
+		details, resp, err := client.Runners.GetRunnerDetails(runner.ID)
+		if err != nil {
+			return wrapGitlabError(err, resp)
+		}
+		if !runnerHasTags(details.TagList, tagList) {
+			continue
+		}
+		if onlineWithin > 0 && !runnerContactedWithin(details, onlineWithin, now) {
+			continue
+		}
+		matches = append(matches, details)
+	}
+
+	d.Set("runners", flattenGitlabRunners(matches))
+	d.SetId(fmt.Sprintf("%d", schema.HashString(optionsHash.String())))
+
+	return nil
+}
+
+func flattenGitlabRunners(runners []*gitlab.RunnerDetails) []interface{} {
+	runnersList := []interface{}{}
+
+	for _, runner := range runners {
+		values := map[string]interface{}{
+			"id":          runner.ID,
+			"description": runner.Description,
+			"online":      runner.Online,
+			"status":      runner.Status,
+		}
+
+		if runner.ContactedAt != nil {
+			values["contacted_at"] = runner.ContactedAt.String()
+		}
+
+		runnersList = append(runnersList, values)
+	}
+
+	return runnersList
+}
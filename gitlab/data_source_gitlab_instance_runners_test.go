@@ -0,0 +1,170 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGitlabInstanceRunners_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			// Register a project runner, which must not be listed
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			{
+				Config: testAccDataSourceGitlabInstanceRunnersConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceRunners("data.gitlab_instance_runners.foo", &runners),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitlabInstanceRunners_read(t *testing.T) {
+	now := time.Now()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/all", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "instance_type" {
+			t.Errorf("got type %q expected instance_type", got)
+		}
+		fmt.Fprint(w, `[{"id": 1, "active": true}, {"id": 2, "active": false}, {"id": 3, "active": true}]`)
+	})
+	mux.HandleFunc("/api/v4/runners/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "active": true, "tag_list": ["docker", "linux"], "contacted_at": %q}`, now.Add(-time.Minute).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/api/v4/runners/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 2, "active": false, "tag_list": ["docker"], "contacted_at": %q}`, now.Add(-time.Minute).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/api/v4/runners/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 3, "active": true, "tag_list": ["linux"], "contacted_at": %q}`, now.Add(-48*time.Hour).Format(time.RFC3339))
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	cases := []struct {
+		Config   map[string]interface{}
+		Expected []int
+	}{
+		{
+			Config:   map[string]interface{}{},
+			Expected: []int{1, 2, 3},
+		},
+		{
+			Config:   map[string]interface{}{"paused": true},
+			Expected: []int{2},
+		},
+		{
+			Config:   map[string]interface{}{"paused": false},
+			Expected: []int{1, 3},
+		},
+		{
+			Config:   map[string]interface{}{"tag_list": []interface{}{"docker"}},
+			Expected: []int{1, 2},
+		},
+		{
+			Config:   map[string]interface{}{"tag_list": []interface{}{"docker", "linux"}},
+			Expected: []int{1},
+		},
+		{
+			Config:   map[string]interface{}{"online_within": "1h"},
+			Expected: []int{1, 2},
+		},
+		{
+			Config:   map[string]interface{}{"paused": false, "tag_list": []interface{}{"linux"}, "online_within": "1h"},
+			Expected: []int{1},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceGitlabInstanceRunners().Schema, tc.Config)
+		if err := dataSourceGitlabInstanceRunnersRead(d, meta); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		ids := []int{}
+		for _, runner := range d.Get("runners").([]interface{}) {
+			ids = append(ids, runner.(map[string]interface{})["id"].(int))
+		}
+		if !reflect.DeepEqual(ids, tc.Expected) {
+			t.Fatalf("got runners %v expected %v for %v", ids, tc.Expected, tc.Config)
+		}
+	}
+}
+
+func TestDataSourceGitlabInstanceRunners_onlineWithin(t *testing.T) {
+	validate := dataSourceGitlabInstanceRunners().Schema["online_within"].ValidateFunc
+
+	for _, value := range []string{"0s", "-1h"} {
+		if _, errors := validate(value, "online_within"); len(errors) == 0 {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+	if _, errors := validate("1h", "online_within"); len(errors) != 0 {
+		t.Fatalf("unexpected errors: %v", errors)
+	}
+}
+
+// testAccCheckGitlabInstanceRunners checks every listed runner is shared and
+// none of the registered project runners are listed.
+func testAccCheckGitlabInstanceRunners(n string, excluded *[]int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		count, _ := strconv.Atoi(rs.Primary.Attributes["runners.#"])
+		for i := 0; i < count; i++ {
+			id, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("runners.%d.id", i)])
+			if err != nil {
+				return err
+			}
+
+			for _, e := range *excluded {
+				if id == e {
+					return fmt.Errorf("Project runner %d listed as an instance runner", id)
+				}
+			}
+
+			runner, _, err := conn.Runners.GetRunnerDetails(id)
+			if err != nil {
+				return err
+			}
+			if !runner.IsShared {
+				return fmt.Errorf("Runner %d is not an instance runner", id)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceGitlabInstanceRunnersConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_instance_runners" "foo" {}
+	`, testAccGitlabRunnerProjectConfig(rInt))
+}
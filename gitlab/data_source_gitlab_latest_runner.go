@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabLatestRunner() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabLatestRunnerRead,
+		Schema: dataSourceGitlabRunnerDetailsSchema(map[string]*schema.Schema{
+			"description_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		}),
+	}
+}
+
+func dataSourceGitlabLatestRunnerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	prefix := d.Get("description_prefix").(string)
+	tagList := *stringSetToStringSlice(d.Get("tag_list").(*schema.Set))
+
+	if prefix == "" && len(tagList) == 0 {
+		return fmt.Errorf("at least one of description_prefix or tag_list must be set")
+	}
+
+	logRedacted(meta, "[INFO] Reading latest Gitlab runner matching description prefix %q and tags %v", prefix, tagList)
+
+	runners, err := listAllGitlabRunners(client, nil)
+	if err != nil {
+		return err
+	}
+
+	var matches []*gitlab.RunnerDetails
+	for _, runner := range runners {
+		if !strings.HasPrefix(runner.Description, prefix) {
+			continue
+		}
+
+		details, resp, err := client.Runners.GetRunnerDetails(runner.ID)
+		if err != nil {
+			return wrapGitlabError(err, resp)
+		}
+		if !runnerHasTags(details.TagList, tagList) {
+			continue
+		}
+		matches = append(matches, details)
+	}
+
+	latest := latestGitlabRunner(matches)
+	if latest == nil {
+		return fmt.Errorf("no runner found matching description prefix %q and tags %v", prefix, tagList)
+	}
+
+	dataSourceGitlabRunnerDetailsSetToState(d, latest)
+
+	return nil
+}
+
+// latestGitlabRunner returns the runner which most recently contacted GitLab,
+// runners which never did coming last. Ties are broken by the highest ID.
+func latestGitlabRunner(runners []*gitlab.RunnerDetails) *gitlab.RunnerDetails {
+	var latest *gitlab.RunnerDetails
+
+	for _, runner := range runners {
+		switch {
+		case latest == nil:
+			latest = runner
+		case runner.ContactedAt == nil:
+			if latest.ContactedAt == nil && runner.ID > latest.ID {
+				latest = runner
+			}
+		case latest.ContactedAt == nil || runner.ContactedAt.After(*latest.ContactedAt):
+			latest = runner
+		case runner.ContactedAt.Equal(*latest.ContactedAt) && runner.ID > latest.ID:
+			latest = runner
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,111 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabLatestRunner_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d-a", rInt), nil, &runners),
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d-b", rInt), nil, &runners),
+				),
+			},
+			// Neither runner ever contacted GitLab, the highest ID wins
+			{
+				Config: testAccDataSourceGitlabLatestRunnerConfig(rInt, fmt.Sprintf("runner-%d-", rInt)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerID("data.gitlab_latest_runner.foo", &runners, 1),
+				),
+			},
+			{
+				Config:      testAccDataSourceGitlabLatestRunnerConfig(rInt, fmt.Sprintf("runner-%d-c", rInt)),
+				ExpectError: regexp.MustCompile(`no runner found matching`),
+			},
+		},
+	})
+}
+
+func TestLatestGitlabRunner(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	cases := []struct {
+		Name     string
+		Runners  []*gitlab.RunnerDetails
+		Expected int
+	}{
+		{
+			Name: "most recent contact",
+			Runners: []*gitlab.RunnerDetails{
+				{ID: 3, ContactedAt: &earlier},
+				{ID: 1, ContactedAt: &now},
+				{ID: 2},
+			},
+			Expected: 1,
+		},
+		{
+			Name: "tie on contact",
+			Runners: []*gitlab.RunnerDetails{
+				{ID: 1, ContactedAt: &now},
+				{ID: 4, ContactedAt: &now},
+				{ID: 2, ContactedAt: &earlier},
+			},
+			Expected: 4,
+		},
+		{
+			Name: "never contacted",
+			Runners: []*gitlab.RunnerDetails{
+				{ID: 5},
+				{ID: 7},
+				{ID: 6},
+			},
+			Expected: 7,
+		},
+		{
+			Name: "contacted after never contacted",
+			Runners: []*gitlab.RunnerDetails{
+				{ID: 9},
+				{ID: 8, ContactedAt: &earlier},
+			},
+			Expected: 8,
+		},
+	}
+
+	for _, tc := range cases {
+		latest := latestGitlabRunner(tc.Runners)
+		if latest == nil || latest.ID != tc.Expected {
+			t.Fatalf("%s: got %v expected runner %d", tc.Name, latest, tc.Expected)
+		}
+	}
+
+	if latest := latestGitlabRunner(nil); latest != nil {
+		t.Fatalf("got %v expected nil", latest)
+	}
+}
+
+func testAccDataSourceGitlabLatestRunnerConfig(rInt int, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_latest_runner" "foo" {
+  description_prefix = "%s"
+}
+	`, testAccGitlabRunnerProjectConfig(rInt), prefix)
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/xanzy/go-gitlab"
 )
 
 func dataSourceGitlabProject() *schema.Resource {
@@ -97,7 +96,7 @@ func dataSourceGitlabProject() *schema.Resource {
 }
 
 func dataSourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	log.Printf("[INFO] Reading Gitlab project")
 
@@ -0,0 +1,44 @@
+package gitlab
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGitlabProjectRunnerIDs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabProjectRunnerIDsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"runner_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabProjectRunnerIDsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	project := d.Get("project_id").(string)
+
+	logRedacted(meta, "[INFO] Reading Gitlab project %s runner IDs", project)
+
+	runners, err := listGitlabProjectRunners(client, project, nil)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, 0, len(runners))
+	for _, runner := range runners {
+		ids = append(ids, runner.ID)
+	}
+
+	d.Set("runner_ids", ids)
+	d.SetId(project)
+
+	return nil
+}
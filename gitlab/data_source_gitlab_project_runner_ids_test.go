@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGitlabProjectRunnerIDs_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d-a", rInt), nil, &runners),
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d-b", rInt), nil, &runners),
+				),
+			},
+			{
+				Config: testAccDataSourceGitlabProjectRunnerIDsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectRunnerIDs("data.gitlab_project_runner_ids.foo", &runners),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabProjectRunnerIDs checks every registered runner is
+// listed. Instance runners may be listed as well.
+func testAccCheckGitlabProjectRunnerIDs(n string, runners *[]int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		listed := map[string]bool{}
+		count, _ := strconv.Atoi(rs.Primary.Attributes["runner_ids.#"])
+		for i := 0; i < count; i++ {
+			listed[rs.Primary.Attributes[fmt.Sprintf("runner_ids.%d", i)]] = true
+		}
+
+		for _, id := range *runners {
+			if !listed[strconv.Itoa(id)] {
+				return fmt.Errorf("Runner %d not listed in %v", id, listed)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceGitlabProjectRunnerIDsConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_project_runner_ids" "foo" {
+  project_id = "${gitlab_project.foo.id}"
+}
+	`, testAccGitlabRunnerProjectConfig(rInt))
+}
@@ -0,0 +1,53 @@
+package gitlab
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGitlabProviderConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabProviderConfigRead,
+		Schema: map[string]*schema.Schema{
+			"base_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"insecure": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"request_timeout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_keep_alives": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitlabProviderConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	c := meta.(*gitlabMeta).config
+
+	logRedacted(meta, "[INFO] Reading Gitlab provider configuration")
+
+	// Never expose the credentials, only how the provider authenticates.
+	d.Set("auth_mode", c.authMode())
+	d.Set("insecure", c.Insecure)
+	d.Set("disable_keep_alives", c.DisableKeepAlives)
+	if c.RequestTimeout > 0 {
+		d.Set("request_timeout", c.RequestTimeout.String())
+	}
+
+	d.Set("base_url", client.BaseURL().String())
+	d.SetId(client.BaseURL().String())
+
+	return nil
+}
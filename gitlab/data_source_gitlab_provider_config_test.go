@@ -0,0 +1,89 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestDataSourceGitlabProviderConfig_read(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gitlab/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "username": "root"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Config{
+		Token:          "secret-token",
+		BaseURL:        server.URL + "/gitlab/api/v4/",
+		RequestTimeout: 30 * time.Second,
+	}
+	meta, err := c.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabProviderConfig().Schema, map[string]interface{}{})
+	if err := dataSourceGitlabProviderConfigRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if got, want := d.Get("base_url").(string), server.URL+"/gitlab/api/v4/"; got != want {
+		t.Fatalf("got base_url %q expected %q", got, want)
+	}
+	if got := d.Get("auth_mode").(string); got != "token" {
+		t.Fatalf("got auth_mode %q expected %q", got, "token")
+	}
+	if got := d.Get("request_timeout").(string); got != "30s" {
+		t.Fatalf("got request_timeout %q expected %q", got, "30s")
+	}
+
+	for k, v := range d.State().Attributes {
+		if strings.Contains(v, "secret-token") {
+			t.Fatalf("attribute %s exposes the token", k)
+		}
+	}
+}
+
+func TestDataSourceGitlabProviderConfig_perProvider(t *testing.T) {
+	first := &gitlabMeta{
+		client: gitlab.NewClient(nil, "token"),
+		config: &Config{Token: "token", RequestTimeout: 30 * time.Second},
+	}
+	second := &gitlabMeta{
+		client: gitlab.NewClient(nil, "token"),
+		config: &Config{OAuthToken: "oauth", DisableKeepAlives: true},
+	}
+
+	for _, tc := range []struct {
+		Meta              *gitlabMeta
+		AuthMode          string
+		RequestTimeout    string
+		DisableKeepAlives bool
+	}{
+		{first, "token", "30s", false},
+		{second, "oauth", "", true},
+	} {
+		d := schema.TestResourceDataRaw(t, dataSourceGitlabProviderConfig().Schema, map[string]interface{}{})
+		if err := dataSourceGitlabProviderConfigRead(d, tc.Meta); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if got := d.Get("auth_mode").(string); got != tc.AuthMode {
+			t.Fatalf("got auth_mode %q expected %q", got, tc.AuthMode)
+		}
+		if got := d.Get("request_timeout").(string); got != tc.RequestTimeout {
+			t.Fatalf("got request_timeout %q expected %q", got, tc.RequestTimeout)
+		}
+		if got := d.Get("disable_keep_alives").(bool); got != tc.DisableKeepAlives {
+			t.Fatalf("got disable_keep_alives %t expected %t", got, tc.DisableKeepAlives)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabRunnerByFilter() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerByFilterRead,
+		Schema: dataSourceGitlabRunnerDetailsSchema(map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		}),
+	}
+}
+
+func dataSourceGitlabRunnerByFilterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	description := d.Get("description").(string)
+	tagList := *stringSetToStringSlice(d.Get("tag_list").(*schema.Set))
+
+	if description == "" && len(tagList) == 0 {
+		return fmt.Errorf("at least one of description or tag_list must be set")
+	}
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner matching description %q and tags %v", description, tagList)
+
+	runners, err := listAllGitlabRunners(client, nil)
+	if err != nil {
+		return err
+	}
+
+	var matches []*gitlab.RunnerDetails
+	for _, runner := range runners {
+		if !strings.Contains(runner.Description, description) {
+			continue
+		}
+
+		// The runner list does not carry tags, so those can only be
+		// checked against the runner details.
+		details, resp, err := client.Runners.GetRunnerDetails(runner.ID)
+		if err != nil {
+			return wrapGitlabError(err, resp)
+		}
+		if !runnerHasTags(details.TagList, tagList) {
+			continue
+		}
+		matches = append(matches, details)
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("no runner found matching description %q and tags %v", description, tagList)
+	}
+	if len(matches) > 1 {
+		ids := make([]string, 0, len(matches))
+		for _, match := range matches {
+			ids = append(ids, strconv.Itoa(match.ID))
+		}
+		return fmt.Errorf("%d runners (%s) match description %q and tags %v, please refine the filter",
+			len(matches), strings.Join(ids, ", "), description, tagList)
+	}
+
+	dataSourceGitlabRunnerDetailsSetToState(d, matches[0])
+
+	return nil
+}
+
+// dataSourceGitlabRunnerDetailsSchema adds the attributes set by
+// dataSourceGitlabRunnerDetailsSetToState to the filters of a data source
+// looking up a single runner.
+func dataSourceGitlabRunnerDetailsSchema(filters map[string]*schema.Schema) map[string]*schema.Schema {
+	attributes := map[string]*schema.Schema{
+		"runner_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"active": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_shared": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"online": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"architecture": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"platform": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"revision": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"contacted_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"access_level": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"maximum_timeout": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+	}
+
+	for k, v := range filters {
+		attributes[k] = v
+	}
+	return attributes
+}
+
+func dataSourceGitlabRunnerDetailsSetToState(d *schema.ResourceData, runner *gitlab.RunnerDetails) {
+	d.Set("runner_id", runner.ID)
+	d.Set("name", runner.Name)
+	d.Set("active", runner.Active)
+	d.Set("is_shared", runner.IsShared)
+	d.Set("online", runner.Online)
+	d.Set("status", runner.Status)
+	d.Set("architecture", runner.Architecture)
+	d.Set("platform", runner.Platform)
+	d.Set("revision", runner.Revision)
+	d.Set("version", runner.Version)
+	d.Set("access_level", runner.AccessLevel)
+	d.Set("maximum_timeout", runner.MaximumTimeout)
+	d.Set("tags", runner.TagList)
+	if runner.ContactedAt != nil {
+		d.Set("contacted_at", runner.ContactedAt.String())
+	}
+
+	d.SetId(fmt.Sprintf("%d", runner.ID))
+}
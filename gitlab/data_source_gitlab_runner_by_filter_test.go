@@ -0,0 +1,67 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGitlabRunnerByFilter_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+	tag := fmt.Sprintf("tf-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			// Register two runners sharing a tag
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d-a", rInt), []string{tag, "a"}, &runners),
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d-b", rInt), []string{tag, "b"}, &runners),
+				),
+			},
+			// Unique match on the description
+			{
+				Config: testAccDataSourceGitlabRunnerByFilterConfig(rInt, fmt.Sprintf(`description = "runner-%d-a"`, rInt)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerID("data.gitlab_runner_by_filter.foo", &runners, 0),
+					resource.TestCheckResourceAttr("data.gitlab_runner_by_filter.foo", "tags.#", "2"),
+				),
+			},
+			// Unique match on the tags
+			{
+				Config: testAccDataSourceGitlabRunnerByFilterConfig(rInt, fmt.Sprintf(`tag_list = ["%s", "b"]`, tag)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerID("data.gitlab_runner_by_filter.foo", &runners, 1),
+				),
+			},
+			// No match
+			{
+				Config:      testAccDataSourceGitlabRunnerByFilterConfig(rInt, fmt.Sprintf(`description = "runner-%d-c"`, rInt)),
+				ExpectError: regexp.MustCompile(`no runner found matching`),
+			},
+			// Multiple matches
+			{
+				Config:      testAccDataSourceGitlabRunnerByFilterConfig(rInt, fmt.Sprintf(`tag_list = ["%s"]`, tag)),
+				ExpectError: regexp.MustCompile(`2 runners \(.+\) match`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGitlabRunnerByFilterConfig(rInt int, filter string) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_by_filter" "foo" {
+  %s
+}
+	`, testAccGitlabRunnerProjectConfig(rInt), filter)
+}
@@ -0,0 +1,61 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabRunnerByIP() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerByIPRead,
+		Schema: dataSourceGitlabRunnerDetailsSchema(map[string]*schema.Schema{
+			"ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		}),
+	}
+}
+
+func dataSourceGitlabRunnerByIPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	ipAddress := d.Get("ip_address").(string)
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner with IP address %s", ipAddress)
+
+	runners, err := listAllGitlabRunners(client, nil)
+	if err != nil {
+		return err
+	}
+
+	var matches []*gitlab.Runner
+	for _, runner := range runners {
+		if runner.IPAddress == ipAddress {
+			matches = append(matches, runner)
+		}
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("no runner found with IP address %s", ipAddress)
+	}
+	if len(matches) > 1 {
+		ids := make([]string, 0, len(matches))
+		for _, match := range matches {
+			ids = append(ids, strconv.Itoa(match.ID))
+		}
+		return fmt.Errorf("%d runners (%s) have IP address %s", len(matches), strings.Join(ids, ", "), ipAddress)
+	}
+
+	runner, resp, err := client.Runners.GetRunnerDetails(matches[0].ID)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	dataSourceGitlabRunnerDetailsSetToState(d, runner)
+
+	return nil
+}
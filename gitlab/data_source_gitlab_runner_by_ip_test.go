@@ -0,0 +1,53 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabRunnerByIP_read(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/all", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id": 1, "description": "a", "ip_address": "10.0.0.1"},
+			{"id": 2, "description": "b", "ip_address": "10.0.0.2"},
+			{"id": 3, "description": "c", "ip_address": "10.0.0.3"},
+			{"id": 4, "description": "d", "ip_address": "10.0.0.3"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/runners/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 2, "description": "b", "status": "online", "tag_list": ["docker"]}`)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	read := func(ip string) (*schema.ResourceData, error) {
+		d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerByIP().Schema, map[string]interface{}{
+			"ip_address": ip,
+		})
+		return d, dataSourceGitlabRunnerByIPRead(d, meta)
+	}
+
+	d, err := read("10.0.0.2")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if d.Id() != "2" || d.Get("runner_id").(int) != 2 {
+		t.Fatalf("got runner %s expected 2", d.Id())
+	}
+	if d.Get("status").(string) != "online" {
+		t.Fatalf("got status %q expected online", d.Get("status"))
+	}
+
+	if _, err := read("10.0.0.9"); err == nil || !strings.Contains(err.Error(), "no runner found") {
+		t.Fatalf("expected no runner to be found, got: %v", err)
+	}
+
+	if _, err := read("10.0.0.3"); err == nil || !strings.Contains(err.Error(), "2 runners (3, 4)") {
+		t.Fatalf("expected several runners to be found, got: %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// GitLab 16.0 introduced the runner creation workflow, where runners are
+// created through the API and get a runner authentication token, and
+// deprecated registration tokens. They keep working until their removal.
+const runnerCreationWorkflowVersion = "16.0"
+
+func dataSourceGitlabRunnerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runner_creation_workflow_available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitlabRunnerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner registration endpoint")
+
+	v, err := gitlabInstanceVersion(client)
+	if err != nil {
+		return err
+	}
+
+	available, err := gitlabVersionAtLeast(v.Version, runnerCreationWorkflowVersion)
+	if err != nil {
+		return err
+	}
+
+	d.Set("version", v.Version)
+	d.Set("runner_creation_workflow_available", available)
+	d.SetId(client.BaseURL().String())
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabRunnerEndpoint_read(t *testing.T) {
+	cases := []struct {
+		Version   string
+		Available bool
+	}{
+		{
+			Version:   "11.10.4-ee",
+			Available: false,
+		},
+		{
+			Version:   "15.11.13",
+			Available: false,
+		},
+		{
+			Version:   "16.0.0-ee",
+			Available: true,
+		},
+		{
+			Version:   "17.2.1",
+			Available: true,
+		},
+	}
+
+	for _, tc := range cases {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"version": %q, "revision": "abc123"}`, tc.Version)
+		})
+		meta, teardown := testGitlabMeta(t, mux)
+
+		d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerEndpoint().Schema, map[string]interface{}{})
+		err := dataSourceGitlabRunnerEndpointRead(d, meta)
+		teardown()
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if got := d.Get("runner_creation_workflow_available").(bool); got != tc.Available {
+			t.Fatalf("got %t expected %t for version %s", got, tc.Available, tc.Version)
+		}
+		if got := d.Get("version").(string); got != tc.Version {
+			t.Fatalf("got %q expected %q", got, tc.Version)
+		}
+	}
+}
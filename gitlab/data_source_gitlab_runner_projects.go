@@ -0,0 +1,76 @@
+package gitlab
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGitlabRunnerProjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"runner_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name_with_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path_with_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabRunnerProjectsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID := d.Get("runner_id").(int)
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner %d projects", runnerID)
+
+	// The runner details carry the full list of projects, unpaginated.
+	runner, resp, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	projects := []interface{}{}
+	for _, project := range runner.Projects {
+		projects = append(projects, map[string]interface{}{
+			"id":                  project.ID,
+			"name":                project.Name,
+			"name_with_namespace": project.NameWithNamespace,
+			"path":                project.Path,
+			"path_with_namespace": project.PathWithNamespace,
+		})
+	}
+
+	d.Set("projects", projects)
+	d.SetId(fmt.Sprintf("%d", runner.ID))
+
+	return nil
+}
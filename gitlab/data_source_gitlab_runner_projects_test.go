@@ -0,0 +1,50 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGitlabRunnerProjects_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			{
+				Config: testAccDataSourceGitlabRunnerProjectsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_runner_projects.foo", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_runner_projects.foo", "projects.0.id", "gitlab_project.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.gitlab_runner_projects.foo", "projects.0.name", "gitlab_project.foo", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGitlabRunnerProjectsConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_by_filter" "foo" {
+  description = "runner-%d"
+}
+
+data "gitlab_runner_projects" "foo" {
+  runner_id = "${data.gitlab_runner_by_filter.foo.runner_id}"
+}
+	`, testAccGitlabRunnerProjectConfig(rInt), rInt)
+}
@@ -0,0 +1,76 @@
+package gitlab
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabRunnerStats() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerStatsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"instance_type", "group_type", "project_type"}, false),
+			},
+			"online": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"offline": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"paused": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitlabRunnerStatsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	options := &gitlab.ListRunnersOptions{}
+	if v, ok := d.GetOk("type"); ok {
+		options.Type = gitlab.String(v.(string))
+	}
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner stats %s", redactedJSON(options))
+
+	runners, err := listAllGitlabRunners(client, options)
+	if err != nil {
+		return err
+	}
+
+	// Paused runners are counted as such whether they are online or not, so
+	// that the buckets add up to the total.
+	var online, offline, paused int
+	for _, runner := range runners {
+		switch {
+		case !runner.Active:
+			paused++
+		case runner.Online:
+			online++
+		default:
+			offline++
+		}
+	}
+
+	d.Set("online", online)
+	d.Set("offline", offline)
+	d.Set("paused", paused)
+	d.Set("total", len(runners))
+	d.SetId(fmt.Sprintf("%d", schema.HashString(d.Get("type").(string))))
+
+	return nil
+}
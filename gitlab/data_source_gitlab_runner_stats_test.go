@@ -0,0 +1,81 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGitlabRunnerStats_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			{
+				Config: testAccDataSourceGitlabRunnerStatsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerStatsTotal("data.gitlab_runner_stats.all"),
+					testAccCheckGitlabRunnerStatsTotal("data.gitlab_runner_stats.project"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabRunnerStatsTotal checks the buckets add up to the total,
+// which includes at least the registered runner.
+func testAccCheckGitlabRunnerStatsTotal(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		sum := 0
+		for _, bucket := range []string{"online", "offline", "paused"} {
+			count, err := strconv.Atoi(rs.Primary.Attributes[bucket])
+			if err != nil {
+				return err
+			}
+			sum += count
+		}
+
+		total, err := strconv.Atoi(rs.Primary.Attributes["total"])
+		if err != nil {
+			return err
+		}
+		if total < 1 {
+			return fmt.Errorf("got total %d; want at least 1", total)
+		}
+		if sum != total {
+			return fmt.Errorf("got buckets summing to %d; want %d", sum, total)
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceGitlabRunnerStatsConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_stats" "all" {}
+
+data "gitlab_runner_stats" "project" {
+  type = "project_type"
+}
+	`, testAccGitlabRunnerProjectConfig(rInt))
+}
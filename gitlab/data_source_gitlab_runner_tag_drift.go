@@ -0,0 +1,88 @@
+package gitlab
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGitlabRunnerTagDrift() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerTagDriftRead,
+		Schema: map[string]*schema.Schema{
+			"runner_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"tag_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"missing": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"extra": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabRunnerTagDriftRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID := d.Get("runner_id").(int)
+	expected := *stringSetToStringSlice(d.Get("tag_list").(*schema.Set))
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner %d tag drift from %v", runnerID, expected)
+
+	runner, resp, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	missing, extra := runnerTagDrift(runner.TagList, expected)
+
+	d.Set("missing", missing)
+	d.Set("extra", extra)
+	d.SetId(fmt.Sprintf("%d", runner.ID))
+
+	return nil
+}
+
+// runnerTagDrift returns the expected tags the runner lacks, and the tags the
+// runner has beyond the expected ones, both sorted.
+func runnerTagDrift(tags []string, expected []string) ([]string, []string) {
+	actual := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		actual[tag] = true
+	}
+	wanted := make(map[string]bool, len(expected))
+	for _, tag := range expected {
+		wanted[tag] = true
+	}
+
+	missing := []string{}
+	for tag := range wanted {
+		if !actual[tag] {
+			missing = append(missing, tag)
+		}
+	}
+	extra := []string{}
+	for tag := range actual {
+		if !wanted[tag] {
+			extra = append(extra, tag)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(extra)
+
+	return missing, extra
+}
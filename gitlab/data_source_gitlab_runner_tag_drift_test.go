@@ -0,0 +1,49 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabRunnerTagDrift_read(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42, "description": "builder", "tag_list": ["docker", "linux", "gpu"]}`)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerTagDrift().Schema, map[string]interface{}{
+		"runner_id": 42,
+		"tag_list":  []interface{}{"docker", "linux", "arm64", "large"},
+	})
+	if err := dataSourceGitlabRunnerTagDriftRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if got, want := d.Get("missing").([]interface{}), []interface{}{"arm64", "large"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got missing %v expected %v", got, want)
+	}
+	if got, want := d.Get("extra").([]interface{}), []interface{}{"gpu"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got extra %v expected %v", got, want)
+	}
+	if d.Id() != "42" {
+		t.Fatalf("got ID %q expected %q", d.Id(), "42")
+	}
+}
+
+func TestRunnerTagDrift(t *testing.T) {
+	missing, extra := runnerTagDrift([]string{"docker"}, []string{"docker"})
+	if len(missing) != 0 || len(extra) != 0 {
+		t.Fatalf("expected no drift, got missing %v and extra %v", missing, extra)
+	}
+
+	missing, extra = runnerTagDrift(nil, []string{"b", "a"})
+	if !reflect.DeepEqual(missing, []string{"a", "b"}) || len(extra) != 0 {
+		t.Fatalf("got missing %v and extra %v", missing, extra)
+	}
+}
@@ -0,0 +1,68 @@
+package gitlab
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabRunnerTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerTagsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"instance_type", "group_type", "project_type"}, false),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabRunnerTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	options := &gitlab.ListRunnersOptions{}
+	if v, ok := d.GetOk("type"); ok {
+		options.Type = gitlab.String(v.(string))
+	}
+
+	logRedacted(meta, "[INFO] Reading Gitlab runner tags %s", redactedJSON(options))
+
+	runners, err := listAllGitlabRunners(client, options)
+	if err != nil {
+		return err
+	}
+
+	// The runner list does not carry tags, so the details of each runner
+	// have to be fetched.
+	seen := make(map[string]bool)
+	for _, runner := range runners {
+		details, resp, err := client.Runners.GetRunnerDetails(runner.ID)
+		if err != nil {
+			return wrapGitlabError(err, resp)
+		}
+		for _, tag := range details.TagList {
+			seen[tag] = true
+		}
+	}
+
+	tags := make([]string, 0, len(seen))
+	for tag := range seen {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	d.Set("tags", tags)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(d.Get("type").(string))))
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabRunnerTags_read(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/all", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "project_type" {
+			t.Errorf("got type %q expected project_type", got)
+		}
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 3}]`)
+	})
+	mux.HandleFunc("/api/v4/runners/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "tag_list": ["linux", "docker"]}`)
+	})
+	mux.HandleFunc("/api/v4/runners/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 2, "tag_list": ["docker", "arm64"]}`)
+	})
+	mux.HandleFunc("/api/v4/runners/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 3, "tag_list": []}`)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerTags().Schema, map[string]interface{}{
+		"type": "project_type",
+	})
+	if err := dataSourceGitlabRunnerTagsRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if got, want := d.Get("tags").([]interface{}), []interface{}{"arm64", "docker", "linux"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got tags %v expected %v", got, want)
+	}
+}
@@ -0,0 +1,70 @@
+package gitlab
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// runnerVerifyRetryTimeout bounds how long transient errors are retried when
+// verifying a runner token.
+const runnerVerifyRetryTimeout = 1 * time.Minute
+
+func dataSourceGitlabRunnerVerify() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabRunnerVerifyRead,
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitlabRunnerVerifyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	token := d.Get("token").(string)
+
+	options := &gitlab.VerifyRegisteredRunnerOptions{
+		Token: gitlab.String(token),
+	}
+
+	logRedacted(meta, "[DEBUG] verify gitlab runner %s", redactedJSON(options))
+
+	// Only a definitive rejection marks the token as invalid, transient
+	// errors are retried rather than reported as such.
+	var resp *gitlab.Response
+	err := resource.Retry(runnerVerifyRetryTimeout, func() *resource.RetryError {
+		var err error
+		resp, err = client.Runners.VerifyRegisteredRunner(options)
+		if err != nil && isRetryableGitlabResponse(resp) {
+			logRedacted(meta, "[DEBUG] retrying gitlab runner token verification after status %d", resp.StatusCode)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+
+	valid := true
+	if err != nil {
+		if resp == nil || (resp.StatusCode != 403 && resp.StatusCode != 404) {
+			return wrapGitlabError(err, resp)
+		}
+		logRedacted(meta, "[DEBUG] gitlab runner token rejected with status %d", resp.StatusCode)
+		valid = false
+	}
+
+	// The verify endpoint does not return the runner, and an ID derived from
+	// the token would leak a hash of it into the state.
+	d.Set("valid", valid)
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
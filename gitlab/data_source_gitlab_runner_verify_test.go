@@ -0,0 +1,134 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGitlabRunnerVerify_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			// An unknown token is reported as invalid rather than erroring,
+			// while a freshly registered runner token is valid
+			{
+				Config: testAccDataSourceGitlabRunnerVerifyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_runner_verify.foo", "valid", "false"),
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+					testAccCheckGitlabRunnerVerify(t, &runners, true),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitlabRunnerVerify_retry(t *testing.T) {
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/verify", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(w, `{"message": "500 Internal Server Error"}`, http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerVerify().Schema, map[string]interface{}{
+		"token": "runner-token",
+	})
+	if err := dataSourceGitlabRunnerVerifyRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("got %d calls expected 2", calls)
+	}
+	if !d.Get("valid").(bool) {
+		t.Fatalf("expected the token to be valid after the transient error")
+	}
+	if d.Id() == "" || d.Id() == fmt.Sprintf("%d", schema.HashString("runner-token")) {
+		t.Fatalf("expected an ID not derived from the token, got %q", d.Id())
+	}
+}
+
+func TestDataSourceGitlabRunnerVerify_invalid(t *testing.T) {
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/verify", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, `{"message": "403 Forbidden"}`, http.StatusForbidden)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerVerify().Schema, map[string]interface{}{
+		"token": "runner-token",
+	})
+	if err := dataSourceGitlabRunnerVerifyRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("got %d calls expected 1", calls)
+	}
+	if d.Get("valid").(bool) {
+		t.Fatalf("expected the token to be invalid")
+	}
+}
+
+// testAccCheckGitlabRunnerVerify runs the data source against the token of
+// the first registered runner, as it is not known when writing the config.
+func testAccCheckGitlabRunnerVerify(t *testing.T, runners *[]int, valid bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		runner, _, err := conn.Runners.GetRunnerDetails((*runners)[0])
+		if err != nil {
+			return err
+		}
+
+		d := schema.TestResourceDataRaw(t, dataSourceGitlabRunnerVerify().Schema, map[string]interface{}{
+			"token": runner.Token,
+		})
+		if err := dataSourceGitlabRunnerVerifyRead(d, conn); err != nil {
+			return err
+		}
+
+		if got := d.Get("valid").(bool); got != valid {
+			return fmt.Errorf("got valid %t; want %t", got, valid)
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceGitlabRunnerVerifyConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+data "gitlab_runner_verify" "foo" {
+  token = "invalid-token-%d"
+}
+	`, rInt, rInt)
+}
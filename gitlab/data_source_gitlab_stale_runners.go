@@ -0,0 +1,98 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabStaleRunners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabStaleRunnersRead,
+		Schema: map[string]*schema.Schema{
+			"stale_after": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePositiveDurationFunc(),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"instance_type", "group_type", "project_type"}, false),
+			},
+			"runners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"online": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"contacted_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabStaleRunnersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	staleAfter, err := time.ParseDuration(d.Get("stale_after").(string))
+	if err != nil {
+		return err
+	}
+
+	options := &gitlab.ListRunnersOptions{}
+	if v, ok := d.GetOk("type"); ok {
+		options.Type = gitlab.String(v.(string))
+	}
+
+	logRedacted(meta, "[INFO] Reading Gitlab runners stale after %s %s", staleAfter, redactedJSON(options))
+
+	runners, err := listAllGitlabRunners(client, options)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	// The runner list does not carry the last contact, so the details of
+	// each runner have to be fetched. Runners which never contacted GitLab
+	// are stale too.
+	var stale []*gitlab.RunnerDetails
+	for _, runner := range runners {
+		details, resp, err := client.Runners.GetRunnerDetails(runner.ID)
+		if err != nil {
+			return wrapGitlabError(err, resp)
+		}
+		if !runnerContactedWithin(details, staleAfter, now) {
+			stale = append(stale, details)
+		}
+	}
+
+	d.Set("runners", flattenGitlabRunners(stale))
+	d.SetId(fmt.Sprintf("%d", schema.HashString(d.Get("stale_after").(string)+","+d.Get("type").(string))))
+
+	return nil
+}
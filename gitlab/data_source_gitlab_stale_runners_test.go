@@ -0,0 +1,69 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabStaleRunners_read(t *testing.T) {
+	now := time.Now()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/all", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "instance_type" {
+			t.Errorf("got type %q expected instance_type", got)
+		}
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 3}]`)
+	})
+	mux.HandleFunc("/api/v4/runners/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "description": "fresh", "contacted_at": %q}`, now.Add(-time.Minute).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/api/v4/runners/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 2, "description": "stale", "contacted_at": %q}`, now.Add(-48*time.Hour).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/api/v4/runners/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 3, "description": "never", "contacted_at": null}`)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	d := schema.TestResourceDataRaw(t, dataSourceGitlabStaleRunners().Schema, map[string]interface{}{
+		"stale_after": "24h",
+		"type":        "instance_type",
+	})
+	if err := dataSourceGitlabStaleRunnersRead(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	runners := d.Get("runners").([]interface{})
+	if len(runners) != 2 {
+		t.Fatalf("got %d stale runners expected 2: %v", len(runners), runners)
+	}
+
+	stale := runners[0].(map[string]interface{})
+	if stale["id"].(int) != 2 || stale["description"].(string) != "stale" || stale["contacted_at"].(string) == "" {
+		t.Fatalf("unexpected stale runner: %v", stale)
+	}
+
+	never := runners[1].(map[string]interface{})
+	if never["id"].(int) != 3 || never["contacted_at"].(string) != "" {
+		t.Fatalf("unexpected never contacted runner: %v", never)
+	}
+}
+
+func TestDataSourceGitlabStaleRunners_staleAfter(t *testing.T) {
+	validate := dataSourceGitlabStaleRunners().Schema["stale_after"].ValidateFunc
+
+	for _, value := range []string{"0s", "-1h"} {
+		if _, errors := validate(value, "stale_after"); len(errors) == 0 {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+	if _, errors := validate("24h", "stale_after"); len(errors) != 0 {
+		t.Fatalf("unexpected errors: %v", errors)
+	}
+}
@@ -137,7 +137,7 @@ func dataSourceGitlabUser() *schema.Resource {
 }
 
 func dataSourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	var user *gitlab.User
 	var err error
@@ -174,7 +174,7 @@ func dataSourceGitlabUsers() *schema.Resource {
 }
 
 func dataSourceGitlabUsersRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	listUsersOptions, id, err := expandGitlabUsersOptions(d)
 	if err != nil {
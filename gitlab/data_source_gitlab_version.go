@@ -0,0 +1,38 @@
+package gitlab
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGitlabVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabVersionRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitlabVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	logRedacted(meta, "[INFO] Reading Gitlab version")
+
+	v, err := gitlabInstanceVersion(client)
+	if err != nil {
+		return err
+	}
+
+	d.Set("version", v.Version)
+	d.Set("revision", v.Revision)
+	d.SetId(client.BaseURL().String())
+
+	return nil
+}
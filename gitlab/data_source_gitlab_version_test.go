@@ -0,0 +1,38 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGitlabVersion_read(t *testing.T) {
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"version": "11.10.4-ee", "revision": "62c42d1"}`)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	for i := 0; i < 2; i++ {
+		d := schema.TestResourceDataRaw(t, dataSourceGitlabVersion().Schema, map[string]interface{}{})
+		if err := dataSourceGitlabVersionRead(d, meta); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if got := d.Get("version").(string); got != "11.10.4-ee" {
+			t.Fatalf("got version %q expected %q", got, "11.10.4-ee")
+		}
+		if got := d.Get("revision").(string); got != "62c42d1" {
+			t.Fatalf("got revision %q expected %q", got, "62c42d1")
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("got %d version calls expected the version to be cached", calls)
+	}
+}
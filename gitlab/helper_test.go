@@ -2,8 +2,15 @@ package gitlab
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
 )
 
 // testAccCompareGitLabAttribute compares an attribute in two ResourceData's for
@@ -36,3 +43,90 @@ func testAccIsSkippedAttribute(needle string, haystack []string) bool {
 	}
 	return false
 }
+
+// testAccRegisterGitlabRunner registers a runner using the runners token of
+// the project in state, and records its ID so that it can be removed by
+// testAccRemoveGitlabRunners once the test is done.
+func testAccRegisterGitlabRunner(project, description string, tags []string, runners *[]int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[project]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", project)
+		}
+
+		token := rs.Primary.Attributes["runners_token"]
+		if token == "" {
+			return fmt.Errorf("No runners token is set")
+		}
+
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		runner, _, err := conn.Runners.RegisterNewRunner(&gitlab.RegisterNewRunnerOptions{
+			Token:       gitlab.String(token),
+			Description: gitlab.String(description),
+			Locked:      gitlab.Bool(false),
+			TagList:     tags,
+		})
+		if err != nil {
+			return err
+		}
+
+		*runners = append(*runners, runner.ID)
+		return nil
+	}
+}
+
+// testAccRemoveGitlabRunners removes the runners registered by
+// testAccRegisterGitlabRunner.
+func testAccRemoveGitlabRunners(runners *[]int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		for _, id := range *runners {
+			resp, err := conn.Runners.RemoveRunner(id)
+			if err != nil && (resp == nil || resp.StatusCode != 404) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// testAccGitlabRunnerProjectConfig is a project whose runners token is used
+// by testAccRegisterGitlabRunner.
+func testAccGitlabRunnerProjectConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+	`, rInt)
+}
+
+// testAccCheckGitlabRunnerID checks the runner_id attribute is the ID of the
+// runner registered at the given index.
+func testAccCheckGitlabRunnerID(n string, runners *[]int, index int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(*runners) <= index {
+			return fmt.Errorf("Runner %d was not registered", index)
+		}
+		return resource.TestCheckResourceAttr(n, "runner_id", strconv.Itoa((*runners)[index]))(s)
+	}
+}
+
+// testGitlabMeta returns a provider meta whose client talks to a mock GitLab API served by
+// mux, along with a function shutting the mock down.
+func testGitlabMeta(t *testing.T, mux *http.ServeMux) (*gitlabMeta, func()) {
+	server := httptest.NewServer(mux)
+
+	client := gitlab.NewClient(nil, "token")
+	if err := client.SetBaseURL(server.URL); err != nil {
+		server.Close()
+		t.Fatalf("err: %s", err)
+	}
+	return &gitlabMeta{client: client, config: &Config{}}, server.Close
+}
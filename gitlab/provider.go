@@ -3,8 +3,10 @@ package gitlab
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -16,10 +18,16 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("GITLAB_TOKEN", nil),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITLAB_TOKEN", ""),
 				Description: descriptions["token"],
 			},
+			"oauth_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITLAB_OAUTH_TOKEN", ""),
+				Description: descriptions["oauth_token"],
+			},
 			"base_url": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -39,33 +47,79 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["insecure"],
 			},
+			"request_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  descriptions["request_timeout"],
+				ValidateFunc: validatePositiveDurationFunc(),
+			},
+			"disable_keep_alives": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["disable_keep_alives"],
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["log_level"],
+				ValidateFunc: validation.StringInSlice(logLevels, true),
+			},
+			"runner_maximum_timeout_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      maxRunnerMaximumTimeout,
+				Description:  descriptions["runner_maximum_timeout_limit"],
+				ValidateFunc: validation.IntAtLeast(minRunnerMaximumTimeout),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"gitlab_group":   dataSourceGitlabGroup(),
-			"gitlab_project": dataSourceGitlabProject(),
-			"gitlab_user":    dataSourceGitlabUser(),
-			"gitlab_users":   dataSourceGitlabUsers(),
+			"gitlab_all_runner_import_ids": dataSourceGitlabAllRunnerImportIDs(),
+			"gitlab_group":                 dataSourceGitlabGroup(),
+			"gitlab_instance_runners":      dataSourceGitlabInstanceRunners(),
+			"gitlab_latest_runner":         dataSourceGitlabLatestRunner(),
+			"gitlab_project":               dataSourceGitlabProject(),
+			"gitlab_project_runner_ids":    dataSourceGitlabProjectRunnerIDs(),
+			"gitlab_provider_config":       dataSourceGitlabProviderConfig(),
+			"gitlab_runner_by_filter":      dataSourceGitlabRunnerByFilter(),
+			"gitlab_runner_by_ip":          dataSourceGitlabRunnerByIP(),
+			"gitlab_runner_endpoint":       dataSourceGitlabRunnerEndpoint(),
+			"gitlab_runner_projects":       dataSourceGitlabRunnerProjects(),
+			"gitlab_runner_stats":          dataSourceGitlabRunnerStats(),
+			"gitlab_runner_tag_drift":      dataSourceGitlabRunnerTagDrift(),
+			"gitlab_runner_tags":           dataSourceGitlabRunnerTags(),
+			"gitlab_runner_verify":         dataSourceGitlabRunnerVerify(),
+			"gitlab_stale_runners":         dataSourceGitlabStaleRunners(),
+			"gitlab_user":                  dataSourceGitlabUser(),
+			"gitlab_users":                 dataSourceGitlabUsers(),
+			"gitlab_version":               dataSourceGitlabVersion(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"gitlab_branch_protection":  resourceGitlabBranchProtection(),
-			"gitlab_tag_protection":     resourceGitlabTagProtection(),
-			"gitlab_group":              resourceGitlabGroup(),
-			"gitlab_project":            resourceGitlabProject(),
-			"gitlab_label":              resourceGitlabLabel(),
-			"gitlab_pipeline_schedule":  resourceGitlabPipelineSchedule(),
-			"gitlab_pipeline_trigger":   resourceGitlabPipelineTrigger(),
-			"gitlab_project_hook":       resourceGitlabProjectHook(),
-			"gitlab_deploy_key":         resourceGitlabDeployKey(),
-			"gitlab_user":               resourceGitlabUser(),
-			"gitlab_project_membership": resourceGitlabProjectMembership(),
-			"gitlab_group_membership":   resourceGitlabGroupMembership(),
-			"gitlab_project_variable":   resourceGitlabProjectVariable(),
-			"gitlab_group_variable":     resourceGitlabGroupVariable(),
-			"gitlab_project_cluster":    resourceGitlabProjectCluster(),
-			"gitlab_service_slack":      resourceGitlabServiceSlack(),
-			"gitlab_service_jira":       resourceGitlabServiceJira(),
+			"gitlab_branch_protection":            resourceGitlabBranchProtection(),
+			"gitlab_tag_protection":               resourceGitlabTagProtection(),
+			"gitlab_group":                        resourceGitlabGroup(),
+			"gitlab_project":                      resourceGitlabProject(),
+			"gitlab_label":                        resourceGitlabLabel(),
+			"gitlab_pipeline_schedule":            resourceGitlabPipelineSchedule(),
+			"gitlab_pipeline_trigger":             resourceGitlabPipelineTrigger(),
+			"gitlab_project_hook":                 resourceGitlabProjectHook(),
+			"gitlab_deploy_key":                   resourceGitlabDeployKey(),
+			"gitlab_user":                         resourceGitlabUser(),
+			"gitlab_project_membership":           resourceGitlabProjectMembership(),
+			"gitlab_group_membership":             resourceGitlabGroupMembership(),
+			"gitlab_project_variable":             resourceGitlabProjectVariable(),
+			"gitlab_group_variable":               resourceGitlabGroupVariable(),
+			"gitlab_project_cluster":              resourceGitlabProjectCluster(),
+			"gitlab_group_projects_enable_runner": resourceGitlabGroupProjectsEnableRunner(),
+			"gitlab_instance_runner_settings":     resourceGitlabInstanceRunnerSettings(),
+			"gitlab_runner_pause":                 resourceGitlabRunnerPause(),
+			"gitlab_runner_projects_exclusive":    resourceGitlabRunnerProjectsExclusive(),
+			"gitlab_runner_timeout":               resourceGitlabRunnerTimeout(),
+			"gitlab_service_slack":                resourceGitlabServiceSlack(),
+			"gitlab_service_jira":                 resourceGitlabServiceJira(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -76,27 +130,67 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
-		"token": "The OAuth token used to connect to GitLab.",
+		"token": "The personal access token used to connect to GitLab.",
+
+		"oauth_token": "The OAuth access token used to connect to GitLab, instead of a personal access token.",
 
 		"base_url": "The GitLab Base API URL",
 
 		"cacert_file": "A file containing the ca certificate to use in case ssl certificate is not from a standard chain",
 
 		"insecure": "Disable SSL verification of API calls",
+
+		"request_timeout": "The timeout of each API call, e.g. 30s. Defaults to no timeout",
+
+		"disable_keep_alives": "Open a new connection for each API call",
+
+		"log_level": "The least severe level of the runner resources log messages, one of TRACE, DEBUG, INFO, WARN or ERROR",
+
+		"runner_maximum_timeout_limit": "The largest maximum_timeout, in seconds, accepted for a runner. Defaults to 30 days",
 	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	config := Config{
-		Token:      d.Get("token").(string),
-		BaseURL:    d.Get("base_url").(string),
-		CACertFile: d.Get("cacert_file").(string),
-		Insecure:   d.Get("insecure").(bool),
+	config, err := providerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	config.minLogLevel, err = parseLogLevel(config.LogLevel)
+	if err != nil {
+		return nil, err
 	}
 
 	return config.Client()
 }
 
+// providerConfig resolves the provider configuration. Each setting is taken
+// from the provider block if set there, then from its environment variable,
+// then from its default.
+func providerConfig(d *schema.ResourceData) (*Config, error) {
+	config := &Config{
+		Token:             d.Get("token").(string),
+		OAuthToken:        d.Get("oauth_token").(string),
+		BaseURL:           d.Get("base_url").(string),
+		CACertFile:        d.Get("cacert_file").(string),
+		Insecure:          d.Get("insecure").(bool),
+		DisableKeepAlives: d.Get("disable_keep_alives").(bool),
+		LogLevel:          d.Get("log_level").(string),
+
+		RunnerMaximumTimeoutLimit: d.Get("runner_maximum_timeout_limit").(int),
+	}
+
+	if v := d.Get("request_timeout").(string); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		config.RequestTimeout = timeout
+	}
+
+	return config, nil
+}
+
 func validateApiURLVersion(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 	if strings.HasSuffix(v, "/api/v3") || strings.HasSuffix(v, "/api/v3/") {
@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -33,3 +34,111 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("GITLAB_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testSetenv sets (or, when value is empty, unsets) an environment variable,
+// and returns a function restoring its previous value.
+func testSetenv(key, value string) func() {
+	previous, set := os.LookupEnv(key)
+	if value == "" {
+		os.Unsetenv(key)
+	} else {
+		os.Setenv(key, value)
+	}
+	return func() {
+		if set {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestProvider_configPrecedence(t *testing.T) {
+	cases := []struct {
+		Name            string
+		Raw             map[string]interface{}
+		Env             map[string]string
+		ExpectedToken   string
+		ExpectedBaseURL string
+		Error           string
+	}{
+		{
+			Name: "provider block over environment",
+			Raw: map[string]interface{}{
+				"token":    "hcl-token",
+				"base_url": "https://hcl.example.com/api/v4/",
+			},
+			Env: map[string]string{
+				"GITLAB_TOKEN":    "env-token",
+				"GITLAB_BASE_URL": "https://env.example.com/api/v4/",
+			},
+			ExpectedToken:   "hcl-token",
+			ExpectedBaseURL: "https://hcl.example.com/api/v4/",
+		},
+		{
+			Name: "environment over default",
+			Raw:  map[string]interface{}{},
+			Env: map[string]string{
+				"GITLAB_TOKEN":    "env-token",
+				"GITLAB_BASE_URL": "https://env.example.com/api/v4/",
+			},
+			ExpectedToken:   "env-token",
+			ExpectedBaseURL: "https://env.example.com/api/v4/",
+		},
+		{
+			Name: "default",
+			Raw: map[string]interface{}{
+				"token": "hcl-token",
+			},
+			ExpectedToken:   "hcl-token",
+			ExpectedBaseURL: "",
+		},
+		{
+			Name:  "no credentials",
+			Raw:   map[string]interface{}{},
+			Error: "set token or oauth_token in the provider block, or the GITLAB_TOKEN or GITLAB_OAUTH_TOKEN environment variable",
+		},
+		{
+			Name: "conflicting credentials",
+			Raw:  map[string]interface{}{},
+			Env: map[string]string{
+				"GITLAB_TOKEN":       "env-token",
+				"GITLAB_OAUTH_TOKEN": "env-oauth-token",
+			},
+			Error: "only one of token and oauth_token can be set",
+		},
+	}
+
+	for _, tc := range cases {
+		var restore []func()
+		for _, key := range []string{"GITLAB_TOKEN", "GITLAB_OAUTH_TOKEN", "GITLAB_BASE_URL"} {
+			restore = append(restore, testSetenv(key, tc.Env[key]))
+		}
+
+		d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, tc.Raw)
+		config, err := providerConfig(d)
+		if err == nil {
+			err = config.validateAuth()
+		}
+
+		for _, f := range restore {
+			f()
+		}
+
+		if tc.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("%s: expected error %q, got: %v", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if config.Token != tc.ExpectedToken {
+			t.Fatalf("%s: got token %q expected %q", tc.Name, config.Token, tc.ExpectedToken)
+		}
+		if config.BaseURL != tc.ExpectedBaseURL {
+			t.Fatalf("%s: got base_url %q expected %q", tc.Name, config.BaseURL, tc.ExpectedBaseURL)
+		}
+	}
+}
@@ -45,7 +45,7 @@ func resourceGitlabBranchProtection() *schema.Resource {
 }
 
 func resourceGitlabBranchProtectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	branch := gitlab.String(d.Get("branch").(string))
 	mergeAccessLevel := accessLevelID[d.Get("merge_access_level").(string)]
@@ -79,7 +79,7 @@ func resourceGitlabBranchProtectionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceGitlabBranchProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project, branch, err := projectAndBranchFromID(d.Id())
 	if err != nil {
 		return err
@@ -103,7 +103,7 @@ func resourceGitlabBranchProtectionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGitlabBranchProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 
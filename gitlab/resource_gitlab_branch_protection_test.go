@@ -71,7 +71,7 @@ func testAccCheckGitlabBranchProtectionExists(n string, pb *gitlab.ProtectedBran
 			return fmt.Errorf("Error in Splitting Project and Branch Ids")
 		}
 
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		pbs, _, err := conn.ProtectedBranches.ListProtectedBranches(project, nil)
 		if err != nil {
@@ -112,7 +112,7 @@ func testAccCheckGitlabBranchProtectionAttributes(pb *gitlab.ProtectedBranch, wa
 }
 
 func testAccCheckGitlabBranchProtectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 	var project string
 	var branch string
 	for _, rs := range s.RootModule().Resources {
@@ -49,7 +49,7 @@ func resourceGitlabDeployKey() *schema.Resource {
 }
 
 func resourceGitlabDeployKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.AddDeployKeyOptions{
 		Title:   gitlab.String(d.Get("title").(string)),
@@ -70,7 +70,7 @@ func resourceGitlabDeployKeyCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceGitlabDeployKeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	deployKeyID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -90,7 +90,7 @@ func resourceGitlabDeployKeyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGitlabDeployKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	deployKeyID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -110,7 +110,7 @@ func testAccCheckGitlabDeployKeyExists(n string, deployKey *gitlab.DeployKey) re
 		if repoName == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		gotDeployKey, _, err := conn.DeployKeys.GetDeployKey(repoName, deployKeyID)
 		if err != nil {
@@ -146,7 +146,7 @@ func testAccCheckGitlabDeployKeyAttributes(deployKey *gitlab.DeployKey, want *te
 }
 
 func testAccCheckGitlabDeployKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
@@ -73,7 +73,7 @@ func resourceGitlabGroup() *schema.Resource {
 }
 
 func resourceGitlabGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	options := &gitlab.CreateGroupOptions{
 		Name:                 gitlab.String(d.Get("name").(string)),
 		LFSEnabled:           gitlab.Bool(d.Get("lfs_enabled").(bool)),
@@ -109,7 +109,7 @@ func resourceGitlabGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	log.Printf("[DEBUG] read gitlab group %s", d.Id())
 
 	group, _, err := client.Groups.GetGroup(d.Id())
@@ -133,7 +133,7 @@ func resourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	options := &gitlab.UpdateGroupOptions{}
 
@@ -174,7 +174,7 @@ func resourceGitlabGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	log.Printf("[DEBUG] Delete gitlab group %s", d.Id())
 
 	_, err := client.Groups.DeleteGroup(d.Id())
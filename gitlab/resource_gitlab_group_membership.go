@@ -49,7 +49,7 @@ func resourceGitlabGroupMembership() *schema.Resource {
 }
 
 func resourceGitlabGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	userId := d.Get("user_id").(int)
 	groupId := d.Get("group_id").(string)
@@ -73,7 +73,7 @@ func resourceGitlabGroupMembershipCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGitlabGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab group groupMember %s", id)
 
@@ -104,7 +104,7 @@ func groupIdAndUserIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	userId := d.Get("user_id").(int)
 	groupId := d.Get("group_id").(string)
@@ -126,7 +126,7 @@ func resourceGitlabGroupMembershipUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGitlabGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	id := d.Id()
 	groupId, userId, e := groupIdAndUserIdFromId(id)
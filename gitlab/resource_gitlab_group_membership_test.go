@@ -51,7 +51,7 @@ func TestAccGitlabGroupMembership_basic(t *testing.T) {
 func testAccCheckGitlabGroupMembershipExists(n string, membership *gitlab.GroupMember) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
@@ -97,7 +97,7 @@ func testAccCheckGitlabGroupMembershipAttributes(membership *gitlab.GroupMember,
 }
 
 func testAccCheckGitlabGroupMembershipDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_group_membership" {
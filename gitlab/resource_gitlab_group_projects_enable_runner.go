@@ -0,0 +1,314 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupProjectsEnableRunner() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupProjectsEnableRunnerCreate,
+		Read:   resourceGitlabGroupProjectsEnableRunnerRead,
+		Update: resourceGitlabGroupProjectsEnableRunnerUpdate,
+		Delete: resourceGitlabGroupProjectsEnableRunnerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitlabGroupProjectsEnableRunnerImporter,
+		},
+
+		CustomizeDiff: resourceGitlabGroupProjectsEnableRunnerCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"runner_id": {
+				Type:     schema.TypeInt,
+				ForceNew: true,
+				Required: true,
+			},
+			"include_subgroups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"project_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+		},
+	}
+}
+
+func resourceGitlabGroupProjectsEnableRunnerCreate(d *schema.ResourceData, meta interface{}) error {
+	groupID := d.Get("group_id").(string)
+	runnerID := strconv.Itoa(d.Get("runner_id").(int))
+
+	// The ID is set first, so that the projects the runner was enabled on
+	// are recorded even if enabling it on others failed.
+	d.SetId(buildEncodedTwoPartID(&groupID, &runnerID))
+
+	if err := resourceGitlabGroupProjectsEnableRunnerApply(d, meta); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupProjectsEnableRunnerRead(d, meta)
+}
+
+func resourceGitlabGroupProjectsEnableRunnerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	groupID, runner, err := parseEncodedTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	runnerID, err := strconv.Atoi(runner)
+	if err != nil {
+		return err
+	}
+
+	logRedacted(meta, "[DEBUG] read gitlab runner %d projects in group %s", runnerID, groupID)
+
+	enabled, err := gitlabRunnerProjectIDs(client, runnerID)
+	if err != nil {
+		return err
+	}
+
+	// Only keep track of the projects the runner is still enabled on, so
+	// that the others are enabled again on the next apply.
+	var projectIDs []int
+	for _, id := range *intSetToIntSlice(d.Get("project_ids").(*schema.Set)) {
+		if enabled[id] {
+			projectIDs = append(projectIDs, id)
+		} else {
+			logRedacted(meta, "[WARN] gitlab runner %d is no longer enabled on project %d", runnerID, id)
+		}
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("runner_id", runnerID)
+	d.Set("project_ids", projectIDs)
+
+	return nil
+}
+
+func resourceGitlabGroupProjectsEnableRunnerUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceGitlabGroupProjectsEnableRunnerApply(d, meta); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupProjectsEnableRunnerRead(d, meta)
+}
+
+func resourceGitlabGroupProjectsEnableRunnerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID := d.Get("runner_id").(int)
+
+	for _, id := range *intSetToIntSlice(d.Get("project_ids").(*schema.Set)) {
+		logRedacted(meta, "[DEBUG] Delete gitlab runner %d from project %d", runnerID, id)
+
+		resp, err := client.Runners.DisableProjectRunner(id, runnerID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return wrapGitlabError(err, resp)
+		}
+	}
+
+	return nil
+}
+
+// resourceGitlabGroupProjectsEnableRunnerImporter takes over the existing
+// enablements of the runner on the projects of the group. The API does not
+// tell which project owns the runner, so it is taken over as well when it is
+// part of the group. The ID is group_id:runner_id, optionally followed by
+// :true to include the projects of the subgroups.
+func resourceGitlabGroupProjectsEnableRunnerImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*gitlabMeta).client
+
+	groupID, runner, err := parseEncodedTwoPartID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	includeSubgroups := false
+	if parts := strings.SplitN(runner, ":", 2); len(parts) == 2 {
+		runner = parts[0]
+		includeSubgroups, err = strconv.ParseBool(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("Unexpected ID format (%q). Expected group_id:runner_id[:include_subgroups]", d.Id())
+		}
+	}
+
+	runnerID, err := strconv.Atoi(runner)
+	if err != nil {
+		return nil, fmt.Errorf("%s cannot be converted to int", runner)
+	}
+
+	details, resp, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		return nil, wrapGitlabError(err, resp)
+	}
+
+	projects, err := listGitlabGroupProjects(client, groupID, &gitlab.ListGroupProjectsOptions{
+		IncludeSubgroups: gitlab.Bool(includeSubgroups),
+		WithShared:       gitlab.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[int]bool, len(details.Projects))
+	for _, project := range details.Projects {
+		enabled[project.ID] = true
+	}
+
+	projectIDs := []int{}
+	for _, project := range projects {
+		if enabled[project.ID] {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+
+	d.SetId(buildEncodedTwoPartID(&groupID, &runner))
+	d.Set("group_id", groupID)
+	d.Set("runner_id", runnerID)
+	d.Set("include_subgroups", includeSubgroups)
+	d.Set("project_ids", projectIDs)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceGitlabGroupProjectsEnableRunnerCustomizeDiff plans an update when
+// the projects of the group no longer match the projects the runner has been
+// enabled on, e.g. as projects were added to the group.
+func resourceGitlabGroupProjectsEnableRunnerCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	client := meta.(*gitlabMeta).client
+
+	wanted, err := gitlabGroupProjectsEnableRunnerWanted(client,
+		d.Get("group_id").(string),
+		d.Get("runner_id").(int),
+		d.Get("include_subgroups").(bool),
+		d.Get("project_ids").(*schema.Set),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !wanted.Equal(d.Get("project_ids").(*schema.Set)) {
+		return d.SetNewComputed("project_ids")
+	}
+	return nil
+}
+
+// resourceGitlabGroupProjectsEnableRunnerApply enables the runner on the
+// wanted projects of the group, and disables it on the projects it was
+// previously enabled on which are no longer wanted. Failures on some projects
+// do not stop the others: they are all reported, while the projects handled
+// successfully are recorded in state so that only the failures are retried.
+func resourceGitlabGroupProjectsEnableRunnerApply(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	groupID := d.Get("group_id").(string)
+	runnerID := d.Get("runner_id").(int)
+
+	old, _ := d.GetChange("project_ids")
+	tracked := old.(*schema.Set)
+
+	wanted, err := gitlabGroupProjectsEnableRunnerWanted(client, groupID, runnerID, d.Get("include_subgroups").(bool), tracked)
+	if err != nil {
+		return err
+	}
+
+	enabled, err := gitlabRunnerProjectIDs(client, runnerID)
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+	projectIDs := schema.NewSet(schema.HashInt, nil)
+
+	for _, id := range *intSetToIntSlice(wanted) {
+		if enabled[id] {
+			projectIDs.Add(id)
+			continue
+		}
+
+		logRedacted(meta, "[DEBUG] enable gitlab runner %d on project %d", runnerID, id)
+
+		_, resp, err := client.Runners.EnableProjectRunner(id, &gitlab.EnableProjectRunnerOptions{
+			RunnerID: runnerID,
+		})
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("enabling runner %d on project %d: %s", runnerID, id, wrapGitlabError(err, resp)))
+			continue
+		}
+		projectIDs.Add(id)
+	}
+
+	for _, id := range *intSetToIntSlice(tracked.Difference(wanted)) {
+		logRedacted(meta, "[DEBUG] disable gitlab runner %d on project %d", runnerID, id)
+
+		resp, err := client.Runners.DisableProjectRunner(id, runnerID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			// The runner is still enabled on the project, keep track of it.
+			errs = multierror.Append(errs, fmt.Errorf("disabling runner %d on project %d: %s", runnerID, id, wrapGitlabError(err, resp)))
+			projectIDs.Add(id)
+		}
+	}
+
+	d.Set("project_ids", projectIDs)
+
+	return errs.ErrorOrNil()
+}
+
+// gitlabGroupProjectsEnableRunnerWanted returns the projects of the group the
+// runner should be enabled on by the resource: those it already tracks, and
+// those the runner is not enabled on yet. Projects the runner was enabled on
+// by other means, such as the project it was registered with, are left alone.
+func gitlabGroupProjectsEnableRunnerWanted(client *gitlab.Client, groupID string, runnerID int, includeSubgroups bool, tracked *schema.Set) (*schema.Set, error) {
+	projects, err := listGitlabGroupProjects(client, groupID, &gitlab.ListGroupProjectsOptions{
+		IncludeSubgroups: gitlab.Bool(includeSubgroups),
+		WithShared:       gitlab.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := gitlabRunnerProjectIDs(client, runnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := schema.NewSet(schema.HashInt, nil)
+	for _, project := range projects {
+		if tracked.Contains(project.ID) || !enabled[project.ID] {
+			wanted.Add(project.ID)
+		}
+	}
+
+	return wanted, nil
+}
+
+// gitlabRunnerProjectIDs returns the IDs of the projects the runner is
+// enabled on.
+func gitlabRunnerProjectIDs(client *gitlab.Client, runnerID int) (map[int]bool, error) {
+	runner, resp, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		return nil, wrapGitlabError(err, resp)
+	}
+
+	ids := make(map[int]bool, len(runner.Projects))
+	for _, project := range runner.Projects {
+		ids[project.ID] = true
+	}
+	return ids, nil
+}
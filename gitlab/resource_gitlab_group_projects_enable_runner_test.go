@@ -0,0 +1,259 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGitlabGroupProjectsEnableRunner_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabGroupProjectsEnableRunnerGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.owner", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			// Enable the runner on the projects of the group
+			{
+				Config: testAccGitlabGroupProjectsEnableRunnerConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_projects_enable_runner.foo", "project_ids.#", "3"),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.foo", true),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.bar", true),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.baz", true),
+				),
+			},
+			// Verify import takes over the enablements
+			{
+				ResourceName:      "gitlab_group_projects_enable_runner.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the resource leaves the registration project alone
+			{
+				Config: testAccGitlabGroupProjectsEnableRunnerGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.owner", true),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.foo", false),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.bar", false),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.baz", false),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitlabGroupProjectsEnableRunner_partialFailure(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/groups/7/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}]`)
+	})
+	mux.HandleFunc("/api/v4/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42, "projects": [{"id": 4}, {"id": 9}]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/runners", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/runners", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "500 Internal Server Error"}`, http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/api/v4/projects/3/runners", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "403 Forbidden"}`, http.StatusForbidden)
+	})
+	mux.HandleFunc("/api/v4/projects/9/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "500 Internal Server Error"}`, http.StatusInternalServerError)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	// Project 9 was enabled by the resource before leaving the group, and
+	// project 4 was enabled by other means
+	d := resourceGitlabGroupProjectsEnableRunner().Data(&terraform.InstanceState{
+		ID: "7:42",
+		Attributes: map[string]string{
+			"group_id":          "7",
+			"runner_id":         "42",
+			"include_subgroups": "false",
+			"project_ids.#":     "1",
+			"project_ids.9":     "9",
+		},
+	})
+
+	err := resourceGitlabGroupProjectsEnableRunnerApply(d, meta)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	for _, want := range []string{"on project 2", "on project 3", "disabling runner 42 on project 9"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected the error to mention %q, got: %s", want, err)
+		}
+	}
+
+	got := *intSetToIntSlice(d.Get("project_ids").(*schema.Set))
+	sort.Ints(got)
+	if want := []int{1, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got project_ids %v expected %v", got, want)
+	}
+}
+
+func TestResourceGitlabGroupProjectsEnableRunner_import(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/groups/7/projects", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include_subgroups") == "true" {
+			fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 3}, {"id": 5}, {"id": 8}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 3}, {"id": 5}]`)
+	})
+	mux.HandleFunc("/api/v4/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42, "projects": [{"id": 1}, {"id": 2}, {"id": 3}, {"id": 8}, {"id": 9}]}`)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	cases := []struct {
+		ID               string
+		IncludeSubgroups bool
+		ProjectIDs       []int
+	}{
+		{
+			ID:         "7:42",
+			ProjectIDs: []int{1, 2, 3},
+		},
+		{
+			ID:               "7:42:true",
+			IncludeSubgroups: true,
+			ProjectIDs:       []int{1, 2, 3, 8},
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceGitlabGroupProjectsEnableRunner().Data(&terraform.InstanceState{ID: tc.ID})
+
+		results, err := resourceGitlabGroupProjectsEnableRunnerImporter(d, meta)
+		if err != nil {
+			t.Fatalf("unexpected error importing %q: %s", tc.ID, err)
+		}
+		d = results[0]
+
+		if d.Id() != "7:42" {
+			t.Fatalf("got ID %q expected %q", d.Id(), "7:42")
+		}
+		if got := d.Get("include_subgroups").(bool); got != tc.IncludeSubgroups {
+			t.Fatalf("got include_subgroups %t expected %t for %q", got, tc.IncludeSubgroups, tc.ID)
+		}
+
+		got := *intSetToIntSlice(d.Get("project_ids").(*schema.Set))
+		sort.Ints(got)
+		if !reflect.DeepEqual(got, tc.ProjectIDs) {
+			t.Fatalf("got project_ids %v expected %v for %q", got, tc.ProjectIDs, tc.ID)
+		}
+	}
+
+	d := resourceGitlabGroupProjectsEnableRunner().Data(&terraform.InstanceState{ID: "7:42:maybe"})
+	if _, err := resourceGitlabGroupProjectsEnableRunnerImporter(d, meta); err == nil {
+		t.Fatalf("expected an error for an invalid include_subgroups suffix")
+	}
+}
+
+func testAccCheckGitlabGroupProjectsEnableRunner(runners *[]int, project string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[project]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", project)
+		}
+		if len(*runners) == 0 {
+			return fmt.Errorf("No runner is registered")
+		}
+
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		runner, _, err := conn.Runners.GetRunnerDetails((*runners)[0])
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, p := range runner.Projects {
+			if fmt.Sprintf("%d", p.ID) == rs.Primary.ID {
+				found = true
+			}
+		}
+
+		if found != enabled {
+			return fmt.Errorf("runner %d enabled on %s: got %t; want %t", runner.ID, project, found, enabled)
+		}
+		return nil
+	}
+}
+
+func testAccGitlabGroupProjectsEnableRunnerGroupConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-group-%[1]d"
+  path = "foo-group-%[1]d"
+}
+
+resource "gitlab_project" "foo" {
+  name         = "foo-%[1]d"
+  namespace_id = "${gitlab_group.foo.id}"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project" "bar" {
+  name         = "bar-%[1]d"
+  namespace_id = "${gitlab_group.foo.id}"
+
+  visibility_level = "public"
+}
+
+resource "gitlab_project" "baz" {
+  name         = "baz-%[1]d"
+  namespace_id = "${gitlab_group.foo.id}"
+
+  visibility_level = "public"
+}
+
+# Outside of the group, to register runners with
+resource "gitlab_project" "owner" {
+  name = "owner-%[1]d"
+
+  visibility_level = "public"
+}
+	`, rInt)
+}
+
+func testAccGitlabGroupProjectsEnableRunnerConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_by_filter" "foo" {
+  description = "runner-%d"
+}
+
+resource "gitlab_group_projects_enable_runner" "foo" {
+  group_id  = "${gitlab_group.foo.id}"
+  runner_id = "${data.gitlab_runner_by_filter.foo.runner_id}"
+}
+	`, testAccGitlabGroupProjectsEnableRunnerGroupConfig(rInt), rInt)
+}
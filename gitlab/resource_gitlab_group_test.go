@@ -167,7 +167,7 @@ func testAccCheckGitlabGroupExists(n string, group *gitlab.Group) resource.TestC
 		if groupID == "" {
 			return fmt.Errorf("No group ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		gotGroup, _, err := conn.Groups.GetGroup(groupID)
 		if err != nil {
@@ -224,7 +224,7 @@ func testAccCheckGitlabGroupAttributes(group *gitlab.Group, want *testAccGitlabG
 }
 
 func testAccCheckGitlabGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_group" {
@@ -44,7 +44,7 @@ func resourceGitlabGroupVariable() *schema.Resource {
 }
 
 func resourceGitlabGroupVariableCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
@@ -70,7 +70,7 @@ func resourceGitlabGroupVariableCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabGroupVariableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	group, key, err := parseTwoPartID(d.Id())
 	if err != nil {
@@ -92,7 +92,7 @@ func resourceGitlabGroupVariableRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGitlabGroupVariableUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
@@ -114,7 +114,7 @@ func resourceGitlabGroupVariableUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabGroupVariableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
 	log.Printf("[DEBUG] Delete gitlab group variable %s/%s", group, key)
@@ -73,7 +73,7 @@ func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.Group
 		if key == "" {
 			return fmt.Errorf("No variable key is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		gotVariable, _, err := conn.GroupVariables.GetVariable(repoName, key)
 		if err != nil {
@@ -109,7 +109,7 @@ func testAccCheckGitlabGroupVariableAttributes(variable *gitlab.GroupVariable, w
 }
 
 func testAccCheckGitlabGroupVariableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_group" {
@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// instanceRunnerSettingsID is the ID of the gitlab_instance_runner_settings
+// singleton.
+const instanceRunnerSettingsID = "instance"
+
+func resourceGitlabInstanceRunnerSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabInstanceRunnerSettingsCreate,
+		Read:   resourceGitlabInstanceRunnerSettingsRead,
+		Update: resourceGitlabInstanceRunnerSettingsUpdate,
+		Delete: resourceGitlabInstanceRunnerSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitlabInstanceRunnerSettingsImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"shared_runners_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"shared_runners_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabInstanceRunnerSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(instanceRunnerSettingsID)
+
+	return resourceGitlabInstanceRunnerSettingsUpdate(d, meta)
+}
+
+func resourceGitlabInstanceRunnerSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	logRedacted(meta, "[DEBUG] read gitlab instance runner settings")
+
+	settings, resp, err := client.Settings.GetSettings()
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	d.Set("shared_runners_enabled", settings.SharedRunnersEnabled)
+	d.Set("shared_runners_text", settings.SharedRunnersText)
+
+	return nil
+}
+
+func resourceGitlabInstanceRunnerSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+
+	options := &gitlab.UpdateSettingsOptions{}
+
+	if v, ok := d.GetOkExists("shared_runners_enabled"); ok {
+		options.SharedRunnersEnabled = gitlab.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("shared_runners_text"); ok {
+		options.SharedRunnersText = gitlab.String(v.(string))
+	}
+
+	logRedacted(meta, "[DEBUG] update gitlab instance runner settings %s", redactedJSON(options))
+
+	_, resp, err := client.Settings.UpdateSettings(options)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	return resourceGitlabInstanceRunnerSettingsRead(d, meta)
+}
+
+func resourceGitlabInstanceRunnerSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// Application settings cannot be removed, so the instance is left with
+	// the last configured values.
+	logRedacted(meta, "[DEBUG] Delete gitlab instance runner settings from state")
+
+	return nil
+}
+
+func resourceGitlabInstanceRunnerSettingsImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(instanceRunnerSettingsID)
+
+	return []*schema.ResourceData{d}, nil
+}
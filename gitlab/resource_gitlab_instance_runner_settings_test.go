@@ -0,0 +1,87 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabInstanceRunnerSettings_basic(t *testing.T) {
+	var settings gitlab.Settings
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Disable the shared runners
+			{
+				Config: testAccGitlabInstanceRunnerSettingsConfig(false, fmt.Sprintf("Shared runners %d", rInt)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceRunnerSettingsExists("gitlab_instance_runner_settings.foo", &settings),
+					testAccCheckGitlabInstanceRunnerSettings(&settings, false, fmt.Sprintf("Shared runners %d", rInt)),
+				),
+			},
+			// Enable them again
+			{
+				Config: testAccGitlabInstanceRunnerSettingsConfig(true, fmt.Sprintf("Shared runners %d updated", rInt)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceRunnerSettingsExists("gitlab_instance_runner_settings.foo", &settings),
+					testAccCheckGitlabInstanceRunnerSettings(&settings, true, fmt.Sprintf("Shared runners %d updated", rInt)),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_instance_runner_settings.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabInstanceRunnerSettingsExists(n string, settings *gitlab.Settings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+		if rs.Primary.ID != instanceRunnerSettingsID {
+			return fmt.Errorf("got ID %q; want %q", rs.Primary.ID, instanceRunnerSettingsID)
+		}
+
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		gotSettings, _, err := conn.Settings.GetSettings()
+		if err != nil {
+			return err
+		}
+		*settings = *gotSettings
+		return nil
+	}
+}
+
+func testAccCheckGitlabInstanceRunnerSettings(settings *gitlab.Settings, enabled bool, text string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if settings.SharedRunnersEnabled != enabled {
+			return fmt.Errorf("got shared_runners_enabled %t; want %t", settings.SharedRunnersEnabled, enabled)
+		}
+		if settings.SharedRunnersText != text {
+			return fmt.Errorf("got shared_runners_text %q; want %q", settings.SharedRunnersText, text)
+		}
+		return nil
+	}
+}
+
+func testAccGitlabInstanceRunnerSettingsConfig(enabled bool, text string) string {
+	return fmt.Sprintf(`
+resource "gitlab_instance_runner_settings" "foo" {
+  shared_runners_enabled = %t
+  shared_runners_text    = %q
+}
+	`, enabled, text)
+}
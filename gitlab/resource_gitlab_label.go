@@ -37,7 +37,7 @@ func resourceGitlabLabel() *schema.Resource {
 }
 
 func resourceGitlabLabelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.CreateLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
@@ -61,7 +61,7 @@ func resourceGitlabLabelCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabLabelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	labelName := d.Id()
 	log.Printf("[DEBUG] read gitlab label %s/%s", project, labelName)
@@ -89,7 +89,7 @@ func resourceGitlabLabelRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabLabelUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.UpdateLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
@@ -111,7 +111,7 @@ func resourceGitlabLabelUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabLabelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] Delete gitlab label %s", d.Id())
 	options := &gitlab.DeleteLabelOptions{
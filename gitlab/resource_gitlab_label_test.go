@@ -71,7 +71,7 @@ func testAccCheckGitlabLabelExists(n string, label *gitlab.Label) resource.TestC
 		if repoName == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		labels, _, err := conn.Labels.ListLabels(repoName, nil)
 		if err != nil {
@@ -112,7 +112,7 @@ func testAccCheckGitlabLabelAttributes(label *gitlab.Label, want *testAccGitlabL
 }
 
 func testAccCheckGitlabLabelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
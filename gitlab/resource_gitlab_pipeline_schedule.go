@@ -49,7 +49,7 @@ func resourceGitlabPipelineSchedule() *schema.Resource {
 }
 
 func resourceGitlabPipelineScheduleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.CreatePipelineScheduleOptions{
 		Description:  gitlab.String(d.Get("description").(string)),
@@ -72,7 +72,7 @@ func resourceGitlabPipelineScheduleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceGitlabPipelineScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	pipelineScheduleID, err := strconv.Atoi(d.Id())
 
@@ -106,7 +106,7 @@ func resourceGitlabPipelineScheduleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGitlabPipelineScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.EditPipelineScheduleOptions{
 		Description:  gitlab.String(d.Get("description").(string)),
@@ -153,7 +153,7 @@ func resourceGitlabPipelineScheduleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceGitlabPipelineScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] Delete gitlab PipelineSchedule %s", d.Id())
 
@@ -78,7 +78,7 @@ func testAccCheckGitlabPipelineScheduleExists(n string, schedule *gitlab.Pipelin
 		if repoName == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		schedules, _, err := conn.PipelineSchedules.ListPipelineSchedules(repoName, nil)
 		if err != nil {
@@ -128,7 +128,7 @@ func testAccCheckGitlabPipelineScheduleAttributes(schedule *gitlab.PipelineSched
 }
 
 func testAccCheckGitlabPipelineScheduleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
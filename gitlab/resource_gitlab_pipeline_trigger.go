@@ -34,7 +34,7 @@ func resourceGitlabPipelineTrigger() *schema.Resource {
 }
 
 func resourceGitlabPipelineTriggerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.AddPipelineTriggerOptions{
 		Description: gitlab.String(d.Get("description").(string)),
@@ -53,7 +53,7 @@ func resourceGitlabPipelineTriggerCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGitlabPipelineTriggerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	pipelineTriggerID, err := strconv.Atoi(d.Id())
 
@@ -85,7 +85,7 @@ func resourceGitlabPipelineTriggerRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabPipelineTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.EditPipelineTriggerOptions{
 		Description: gitlab.String(d.Get("description").(string)),
@@ -112,7 +112,7 @@ func resourceGitlabPipelineTriggerUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGitlabPipelineTriggerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] Delete gitlab PipelineTrigger %s", d.Id())
 
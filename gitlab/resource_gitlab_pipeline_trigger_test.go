@@ -66,7 +66,7 @@ func testAccCheckGitlabPipelineTriggerExists(n string, trigger *gitlab.PipelineT
 		if repoName == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		triggers, _, err := conn.PipelineTriggers.ListPipelineTriggers(repoName, nil)
 		if err != nil {
@@ -97,7 +97,7 @@ func testAccCheckGitlabPipelineTriggerAttributes(trigger *gitlab.PipelineTrigger
 }
 
 func testAccCheckGitlabPipelineTriggerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
@@ -191,7 +191,7 @@ func resourceGitlabProjectSetToState(d *schema.ResourceData, project *gitlab.Pro
 }
 
 func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	options := &gitlab.CreateProjectOptions{
 		Name:                             gitlab.String(d.Get("name").(string)),
@@ -290,7 +290,7 @@ func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	log.Printf("[DEBUG] read gitlab project %s", d.Id())
 
 	project, _, err := client.Projects.GetProject(d.Id(), nil)
@@ -303,7 +303,7 @@ func resourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	options := &gitlab.EditProjectOptions{}
 
@@ -436,7 +436,7 @@ func resourceGitlabProjectUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGitlabProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
 
 	_, err := client.Projects.DeleteProject(d.Id())
@@ -537,7 +537,7 @@ func getGroupsProjectSharedWith(project *gitlab.Project) []*gitlab.ShareWithGrou
 }
 
 func updateSharedWithGroups(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	var groupsToUnshare []*gitlab.ShareWithGroupOptions
 	var groupsToShare []*gitlab.ShareWithGroupOptions
@@ -596,7 +596,7 @@ func updateSharedWithGroups(d *schema.ResourceData, meta interface{}) error {
 // idempotent).
 func archiveProject(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[TRACE] Project (%s) will be archived", d.Id())
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	out, _, err := client.Projects.ArchiveProject(d.Id())
 	if err != nil {
 		log.Printf("[ERROR] Error archiving project (%s), received %#v", d.Id(), err)
@@ -615,7 +615,7 @@ func archiveProject(d *schema.ResourceData, meta interface{}) error {
 // idempotent).
 func unarchiveProject(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Project (%s) will be unarchived", d.Id())
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	out, _, err := client.Projects.UnarchiveProject(d.Id())
 	if err != nil {
 		log.Printf("[ERROR] Error unarchiving project (%s), received %#v", d.Id(), err)
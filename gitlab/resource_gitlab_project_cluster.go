@@ -96,7 +96,7 @@ func resourceGitlabProjectCluster() *schema.Resource {
 }
 
 func resourceGitlabProjectClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 
 	pk := gitlab.AddPlatformKubernetesOptions{
@@ -146,7 +146,7 @@ func resourceGitlabProjectClusterCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGitlabProjectClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -178,7 +178,7 @@ func resourceGitlabProjectClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabProjectClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -233,7 +233,7 @@ func resourceGitlabProjectClusterUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGitlabProjectClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
 		return err
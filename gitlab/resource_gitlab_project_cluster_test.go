@@ -128,7 +128,7 @@ func testAccCheckGitlabProjectClusterExists(n string, cluster *gitlab.ProjectClu
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		gotCluster, _, err := conn.ProjectCluster.GetCluster(project, clusterID)
 		if err != nil {
@@ -142,7 +142,7 @@ func testAccCheckGitlabProjectClusterExists(n string, cluster *gitlab.ProjectClu
 }
 
 func testAccCheckGitlabProjectClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project_cluster" {
@@ -80,7 +80,7 @@ func resourceGitlabProjectHook() *schema.Resource {
 }
 
 func resourceGitlabProjectHookCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	options := &gitlab.AddProjectHookOptions{
 		URL:                   gitlab.String(d.Get("url").(string)),
@@ -112,7 +112,7 @@ func resourceGitlabProjectHookCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGitlabProjectHookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -139,7 +139,7 @@ func resourceGitlabProjectHookRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceGitlabProjectHookUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -173,7 +173,7 @@ func resourceGitlabProjectHookUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGitlabProjectHookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -82,7 +82,7 @@ func testAccCheckGitlabProjectHookExists(n string, hook *gitlab.ProjectHook) res
 		if repoName == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		gotHook, _, err := conn.Projects.GetProjectHook(repoName, hookID)
 		if err != nil {
@@ -153,7 +153,7 @@ func testAccCheckGitlabProjectHookAttributes(hook *gitlab.ProjectHook, want *tes
 }
 
 func testAccCheckGitlabProjectHookDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
@@ -46,7 +46,7 @@ func resourceGitlabProjectMembership() *schema.Resource {
 }
 
 func resourceGitlabProjectMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
@@ -68,7 +68,7 @@ func resourceGitlabProjectMembershipCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceGitlabProjectMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab project projectMember %s", id)
 
@@ -99,7 +99,7 @@ func projectIdAndUserIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
@@ -118,7 +118,7 @@ func resourceGitlabProjectMembershipUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceGitlabProjectMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	id := d.Id()
 	projectId, userId, e := projectIdAndUserIdFromId(id)
@@ -50,7 +50,7 @@ func TestAccGitlabProjectMembership_basic(t *testing.T) {
 func testAccCheckGitlabProjectMembershipExists(n string, membership *gitlab.ProjectMember) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
@@ -95,7 +95,7 @@ func testAccCheckGitlabProjectMembershipAttributes(membership *gitlab.ProjectMem
 }
 
 func testAccCheckGitlabProjectMembershipDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project_membership" {
@@ -259,7 +259,7 @@ func testAccCheckGitlabProjectExists(n string, project *gitlab.Project) resource
 		if repoName == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 		if g, _, err := conn.Projects.GetProject(repoName, nil); err == nil {
 			*project = *g
 		}
@@ -268,7 +268,7 @@ func testAccCheckGitlabProjectExists(n string, project *gitlab.Project) resource
 }
 
 func testAccCheckGitlabProjectDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
 			continue
@@ -44,7 +44,7 @@ func resourceGitlabProjectVariable() *schema.Resource {
 }
 
 func resourceGitlabProjectVariableCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
@@ -70,7 +70,7 @@ func resourceGitlabProjectVariableCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGitlabProjectVariableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project, key, err := parseTwoPartID(d.Id())
 	if err != nil {
@@ -92,7 +92,7 @@ func resourceGitlabProjectVariableRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabProjectVariableUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
@@ -115,7 +115,7 @@ func resourceGitlabProjectVariableUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGitlabProjectVariableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
 	log.Printf("[DEBUG] Delete gitlab project variable %s/%s", project, key)
@@ -73,7 +73,7 @@ func testAccCheckGitlabProjectVariableExists(n string, projectVariable *gitlab.P
 		if key == "" {
 			return fmt.Errorf("No variable key is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		gotVariable, _, err := conn.ProjectVariables.GetVariable(repoName, key)
 		if err != nil {
@@ -109,7 +109,7 @@ func testAccCheckGitlabProjectVariableAttributes(variable *gitlab.ProjectVariabl
 }
 
 func testAccCheckGitlabProjectVariableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
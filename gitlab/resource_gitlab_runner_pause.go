@@ -0,0 +1,88 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabRunnerPause() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabRunnerPauseCreate,
+		Read:   resourceGitlabRunnerPauseRead,
+		Update: resourceGitlabRunnerPauseUpdate,
+		Delete: resourceGitlabRunnerPauseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"runner_id": {
+				Type:     schema.TypeInt,
+				ForceNew: true,
+				Required: true,
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabRunnerPauseCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(strconv.Itoa(d.Get("runner_id").(int)))
+
+	return resourceGitlabRunnerPauseUpdate(d, meta)
+}
+
+func resourceGitlabRunnerPauseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	logRedacted(meta, "[DEBUG] read gitlab runner %d paused state", runnerID)
+
+	runner, resp, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	d.Set("runner_id", runner.ID)
+	d.Set("paused", !runner.Active)
+
+	return nil
+}
+
+func resourceGitlabRunnerPauseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	options := &gitlab.UpdateRunnerDetailsOptions{
+		Active: gitlab.Bool(!d.Get("paused").(bool)),
+	}
+
+	logRedacted(meta, "[DEBUG] update gitlab runner %d %s", runnerID, redactedJSON(options))
+
+	_, resp, err := client.Runners.UpdateRunnerDetails(runnerID, options)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	return resourceGitlabRunnerPauseRead(d, meta)
+}
+
+func resourceGitlabRunnerPauseDelete(d *schema.ResourceData, meta interface{}) error {
+	// The runner is left in its last configured state, so that removing the
+	// resource does not resume a runner paused for maintenance.
+	logRedacted(meta, "[DEBUG] Delete gitlab runner %s paused state from state", d.Id())
+
+	return nil
+}
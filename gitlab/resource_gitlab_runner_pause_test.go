@@ -0,0 +1,107 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabRunnerPause_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			// Pause the runner
+			{
+				Config: testAccGitlabRunnerPauseConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerPaused("gitlab_runner_pause.foo", true),
+				),
+			},
+			// Unpause the runner
+			{
+				Config: testAccGitlabRunnerPauseConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerPaused("gitlab_runner_pause.foo", false),
+				),
+			},
+			// Pause it outside of Terraform, the drift is reconciled
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*gitlabMeta).client
+					if _, _, err := conn.Runners.UpdateRunnerDetails(runners[0], &gitlab.UpdateRunnerDetailsOptions{
+						Active: gitlab.Bool(false),
+					}); err != nil {
+						t.Fatalf("err: %s", err)
+					}
+				},
+				Config: testAccGitlabRunnerPauseConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerPaused("gitlab_runner_pause.foo", false),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_runner_pause.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabRunnerPaused(n string, paused bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		runnerID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		runner, _, err := conn.Runners.GetRunnerDetails(runnerID)
+		if err != nil {
+			return err
+		}
+
+		if runner.Active == paused {
+			return fmt.Errorf("got active %t; want paused %t", runner.Active, paused)
+		}
+		return nil
+	}
+}
+
+func testAccGitlabRunnerPauseConfig(rInt int, paused bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_by_filter" "foo" {
+  description = "runner-%d"
+}
+
+resource "gitlab_runner_pause" "foo" {
+  runner_id = "${data.gitlab_runner_by_filter.foo.runner_id}"
+  paused    = %t
+}
+	`, testAccGitlabRunnerProjectConfig(rInt), rInt, paused)
+}
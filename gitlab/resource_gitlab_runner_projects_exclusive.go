@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabRunnerProjectsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabRunnerProjectsExclusiveCreate,
+		Read:   resourceGitlabRunnerProjectsExclusiveRead,
+		Update: resourceGitlabRunnerProjectsExclusiveUpdate,
+		Delete: resourceGitlabRunnerProjectsExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"runner_id": {
+				Type:     schema.TypeInt,
+				ForceNew: true,
+				Required: true,
+			},
+			"project_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+		},
+	}
+}
+
+func resourceGitlabRunnerProjectsExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(strconv.Itoa(d.Get("runner_id").(int)))
+
+	return resourceGitlabRunnerProjectsExclusiveUpdate(d, meta)
+}
+
+func resourceGitlabRunnerProjectsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	logRedacted(meta, "[DEBUG] read gitlab runner %d projects", runnerID)
+
+	enabled, err := gitlabRunnerProjectIDs(client, runnerID)
+	if err != nil {
+		return err
+	}
+
+	var projectIDs []int
+	for id := range enabled {
+		projectIDs = append(projectIDs, id)
+	}
+
+	d.Set("runner_id", runnerID)
+	d.Set("project_ids", projectIDs)
+
+	return nil
+}
+
+func resourceGitlabRunnerProjectsExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	wanted := d.Get("project_ids").(*schema.Set)
+
+	enabled, err := gitlabRunnerProjectIDs(client, runnerID)
+	if err != nil {
+		return err
+	}
+
+	// Enable the runner on the new projects before pruning the others. The
+	// API does not tell which project owns the runner, so pruning it is left
+	// to GitLab to refuse, as it does for the last project of a runner.
+	for _, id := range *intSetToIntSlice(wanted) {
+		if enabled[id] {
+			continue
+		}
+
+		logRedacted(meta, "[DEBUG] enable gitlab runner %d on project %d", runnerID, id)
+
+		_, resp, err := client.Runners.EnableProjectRunner(id, &gitlab.EnableProjectRunnerOptions{
+			RunnerID: runnerID,
+		})
+		if err != nil {
+			return wrapGitlabError(err, resp)
+		}
+	}
+
+	for id := range enabled {
+		if wanted.Contains(id) {
+			continue
+		}
+
+		logRedacted(meta, "[DEBUG] disable gitlab runner %d on project %d", runnerID, id)
+
+		resp, err := client.Runners.DisableProjectRunner(id, runnerID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("disabling runner %d on project %d: %s", runnerID, id, wrapGitlabError(err, resp))
+		}
+	}
+
+	return resourceGitlabRunnerProjectsExclusiveRead(d, meta)
+}
+
+func resourceGitlabRunnerProjectsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// A runner has to stay enabled on at least one project, so the project
+	// assignments are left as they are.
+	logRedacted(meta, "[DEBUG] Delete gitlab runner %s projects from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGitlabRunnerProjectsExclusive_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabGroupProjectsEnableRunnerGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			// Add a project to the runner
+			{
+				Config: testAccGitlabRunnerProjectsExclusiveConfig(rInt, `"${gitlab_project.foo.id}", "${gitlab_project.bar.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_runner_projects_exclusive.foo", "project_ids.#", "2"),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.foo", true),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.bar", true),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.baz", false),
+				),
+			},
+			// Add a project and prune another, the registration project stays
+			{
+				Config: testAccGitlabRunnerProjectsExclusiveConfig(rInt, `"${gitlab_project.foo.id}", "${gitlab_project.baz.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_runner_projects_exclusive.foo", "project_ids.#", "2"),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.foo", true),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.bar", false),
+					testAccCheckGitlabGroupProjectsEnableRunner(&runners, "gitlab_project.baz", true),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_runner_projects_exclusive.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitlabRunnerProjectsExclusive_update(t *testing.T) {
+	var calls []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42, "projects": [{"id": 1}, {"id": 2}, {"id": 3}]}`)
+	})
+	for _, id := range []int{1, 2, 3, 4} {
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/%d/runners", id), func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": 42}`)
+		})
+	}
+	for _, id := range []int{2, 3} {
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/%d/runners/42", id), func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	// GitLab refuses to disable the runner on the project owning it
+	mux.HandleFunc("/api/v4/projects/1/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		http.Error(w, `{"message": "403 Forbidden"}`, http.StatusForbidden)
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	update := func(projectIDs ...string) error {
+		calls = nil
+		attributes := map[string]string{
+			"runner_id":     "42",
+			"project_ids.#": fmt.Sprintf("%d", len(projectIDs)),
+		}
+		for _, id := range projectIDs {
+			attributes["project_ids."+id] = id
+		}
+		d := resourceGitlabRunnerProjectsExclusive().Data(&terraform.InstanceState{ID: "42", Attributes: attributes})
+		return resourceGitlabRunnerProjectsExclusiveUpdate(d, meta)
+	}
+
+	if err := update("1", "4"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got := strings.Join(calls, ",")
+	for _, want := range []string{"POST /api/v4/projects/4/runners", "DELETE /api/v4/projects/2/runners/42", "DELETE /api/v4/projects/3/runners/42"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in calls: %s", want, got)
+		}
+	}
+	if strings.Contains(got, "/projects/1/") {
+		t.Fatalf("unexpected call on a wanted project: %s", got)
+	}
+
+	err := update("4")
+	if err == nil || !strings.Contains(err.Error(), "disabling runner 42 on project 1") {
+		t.Fatalf("expected the refusal to be reported, got: %v", err)
+	}
+	if !strings.HasPrefix(strings.Join(calls, ","), "POST /api/v4/projects/4/runners") {
+		t.Fatalf("expected the runner to be enabled before pruning, got: %v", calls)
+	}
+}
+
+func testAccGitlabRunnerProjectsExclusiveConfig(rInt int, projectIDs string) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_by_filter" "foo" {
+  description = "runner-%d"
+}
+
+resource "gitlab_runner_projects_exclusive" "foo" {
+  runner_id   = "${data.gitlab_runner_by_filter.foo.runner_id}"
+  project_ids = [%s]
+}
+	`, testAccGitlabGroupProjectsEnableRunnerGroupConfig(rInt), rInt, projectIDs)
+}
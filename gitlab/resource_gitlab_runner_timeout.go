@@ -0,0 +1,106 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabRunnerTimeout() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabRunnerTimeoutCreate,
+		Read:   resourceGitlabRunnerTimeoutRead,
+		Update: resourceGitlabRunnerTimeoutUpdate,
+		Delete: resourceGitlabRunnerTimeoutDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceGitlabRunnerTimeoutCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"runner_id": {
+				Type:     schema.TypeInt,
+				ForceNew: true,
+				Required: true,
+			},
+			"maximum_timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(minRunnerMaximumTimeout),
+			},
+		},
+	}
+}
+
+// resourceGitlabRunnerTimeoutCustomizeDiff checks the maximum timeout against
+// the bounds allowed by the provider, as the upper one is configurable.
+func resourceGitlabRunnerTimeoutCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("maximum_timeout") {
+		return nil
+	}
+
+	limit := runnerMaximumTimeoutLimit(meta.(*gitlabMeta))
+	_, errors := validateRunnerMaximumTimeoutFunc(minRunnerMaximumTimeout, limit)(d.Get("maximum_timeout"), "maximum_timeout")
+	if len(errors) > 0 {
+		return errors[0]
+	}
+	return nil
+}
+
+func resourceGitlabRunnerTimeoutCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(strconv.Itoa(d.Get("runner_id").(int)))
+
+	return resourceGitlabRunnerTimeoutUpdate(d, meta)
+}
+
+func resourceGitlabRunnerTimeoutRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	logRedacted(meta, "[DEBUG] read gitlab runner %d maximum timeout", runnerID)
+
+	runner, resp, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	d.Set("runner_id", runner.ID)
+	d.Set("maximum_timeout", runner.MaximumTimeout)
+
+	return nil
+}
+
+func resourceGitlabRunnerTimeoutUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlabMeta).client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	options := &gitlab.UpdateRunnerDetailsOptions{
+		MaximumTimeout: gitlab.Int(d.Get("maximum_timeout").(int)),
+	}
+
+	logRedacted(meta, "[DEBUG] update gitlab runner %d %s", runnerID, redactedJSON(options))
+
+	_, resp, err := client.Runners.UpdateRunnerDetails(runnerID, options)
+	if err != nil {
+		return wrapGitlabError(err, resp)
+	}
+
+	return resourceGitlabRunnerTimeoutRead(d, meta)
+}
+
+func resourceGitlabRunnerTimeoutDelete(d *schema.ResourceData, meta interface{}) error {
+	// The maximum timeout of a runner cannot be unset through the API, so
+	// the runner is left with the last configured value.
+	logRedacted(meta, "[DEBUG] Delete gitlab runner %s maximum timeout from state", d.Id())
+
+	return nil
+}
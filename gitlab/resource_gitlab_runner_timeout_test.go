@@ -0,0 +1,136 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabRunnerTimeout_basic(t *testing.T) {
+	var runners []int
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRemoveGitlabRunners(&runners),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabRunnerProjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegisterGitlabRunner("gitlab_project.foo", fmt.Sprintf("runner-%d", rInt), nil, &runners),
+				),
+			},
+			// Set the maximum timeout
+			{
+				Config: testAccGitlabRunnerTimeoutConfig(rInt, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerTimeout("gitlab_runner_timeout.foo", 3600),
+				),
+			},
+			// Update the maximum timeout
+			{
+				Config: testAccGitlabRunnerTimeoutConfig(rInt, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRunnerTimeout("gitlab_runner_timeout.foo", 7200),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_runner_timeout.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Verify the bounds are enforced
+			{
+				Config:      testAccGitlabRunnerTimeoutConfig(rInt, 300),
+				ExpectError: regexp.MustCompile(`expected maximum_timeout to be at least \(600\), got 300`),
+			},
+			{
+				Config:      testAccGitlabRunnerTimeoutConfig(rInt, 2592001),
+				ExpectError: regexp.MustCompile(`expected maximum_timeout to be in the range \(600 - 2592000\) seconds`),
+			},
+		},
+	})
+}
+
+func TestResourceGitlabRunnerTimeout_limit(t *testing.T) {
+	meta := &gitlabMeta{client: gitlab.NewClient(nil, ""), config: &Config{}}
+
+	diff := func(timeout int) error {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"runner_id":       42,
+			"maximum_timeout": timeout,
+		})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		_, err = resourceGitlabRunnerTimeout().Diff(nil, terraform.NewResourceConfig(raw), meta)
+		return err
+	}
+
+	if err := diff(maxRunnerMaximumTimeout); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err := diff(maxRunnerMaximumTimeout + 1)
+	if err == nil || !regexp.MustCompile(`expected maximum_timeout to be in the range \(600 - 2592000\) seconds`).MatchString(err.Error()) {
+		t.Fatalf("expected the default limit to be enforced, got: %v", err)
+	}
+
+	meta.config.RunnerMaximumTimeoutLimit = 3 * maxRunnerMaximumTimeout
+	if err := diff(maxRunnerMaximumTimeout + 1); err != nil {
+		t.Fatalf("unexpected error with a raised limit: %s", err)
+	}
+	err = diff(3*maxRunnerMaximumTimeout + 1)
+	if err == nil || !regexp.MustCompile(`expected maximum_timeout to be in the range \(600 - 7776000\) seconds`).MatchString(err.Error()) {
+		t.Fatalf("expected the raised limit to be enforced, got: %v", err)
+	}
+}
+
+func testAccCheckGitlabRunnerTimeout(n string, timeout int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		runnerID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*gitlabMeta).client
+
+		runner, _, err := conn.Runners.GetRunnerDetails(runnerID)
+		if err != nil {
+			return err
+		}
+
+		if runner.MaximumTimeout != timeout {
+			return fmt.Errorf("got maximum_timeout %d; want %d", runner.MaximumTimeout, timeout)
+		}
+		return nil
+	}
+}
+
+func testAccGitlabRunnerTimeoutConfig(rInt int, timeout int) string {
+	return fmt.Sprintf(`
+%s
+
+data "gitlab_runner_by_filter" "foo" {
+  description = "runner-%d"
+}
+
+resource "gitlab_runner_timeout" "foo" {
+  runner_id       = "${data.gitlab_runner_by_filter.foo.runner_id}"
+  maximum_timeout = %d
+}
+	`, testAccGitlabRunnerProjectConfig(rInt), rInt, timeout)
+}
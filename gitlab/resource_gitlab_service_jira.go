@@ -68,7 +68,7 @@ func resourceGitlabServiceJira() *schema.Resource {
 }
 
 func resourceGitlabServiceJiraCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project := d.Get("project").(string)
 
@@ -90,7 +90,7 @@ func resourceGitlabServiceJiraCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGitlabServiceJiraRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] Read Gitlab Jira service %s", d.Id())
@@ -133,7 +133,7 @@ func resourceGitlabServiceJiraUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGitlabServiceJiraDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	project := d.Get("project").(string)
 
@@ -91,7 +91,7 @@ func testAccCheckGitlabServiceJiraExists(n string, service *gitlab.JiraService)
 		if project == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		jiraService, _, err := conn.Services.GetJiraService(project)
 		if err != nil {
@@ -104,7 +104,7 @@ func testAccCheckGitlabServiceJiraExists(n string, service *gitlab.JiraService)
 }
 
 func testAccCheckGitlabServiceJiraDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
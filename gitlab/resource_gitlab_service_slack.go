@@ -163,7 +163,7 @@ func resourceGitlabServiceSlackSetToState(d *schema.ResourceData, service *gitla
 }
 
 func resourceGitlabServiceSlackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] create gitlab slack service for project %s", project)
@@ -202,7 +202,7 @@ func resourceGitlabServiceSlackCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabServiceSlackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] read gitlab slack service for project %s", project)
@@ -222,7 +222,7 @@ func resourceGitlabServiceSlackUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabServiceSlackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] delete gitlab slack service for project %s", project)
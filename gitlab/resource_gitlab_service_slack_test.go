@@ -111,7 +111,7 @@ func testAccCheckGitlabServiceExists(n string, service *gitlab.SlackService) res
 		if project == "" {
 			return fmt.Errorf("No project ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		slackService, _, err := conn.Services.GetSlackService(project)
 		if err != nil {
@@ -124,7 +124,7 @@ func testAccCheckGitlabServiceExists(n string, service *gitlab.SlackService) res
 }
 
 func testAccCheckGitlabServiceSlackDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project" {
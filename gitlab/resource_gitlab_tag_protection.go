@@ -39,7 +39,7 @@ func resourceGitlabTagProtection() *schema.Resource {
 }
 
 func resourceGitlabTagProtectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	tag := gitlab.String(d.Get("tag").(string))
 	createAccessLevel := accessLevelID[d.Get("create_access_level").(string)]
@@ -71,7 +71,7 @@ func resourceGitlabTagProtectionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGitlabTagProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project, tag, err := projectAndTagFromID(d.Id())
 	if err != nil {
 		return err
@@ -94,7 +94,7 @@ func resourceGitlabTagProtectionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGitlabTagProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	project := d.Get("project").(string)
 	tag := d.Get("tag").(string)
 
@@ -117,7 +117,7 @@ func testAccCheckGitlabTagProtectionExists(n string, pt *gitlab.ProtectedTag) re
 			return fmt.Errorf("Error in Splitting Project and Tag Ids")
 		}
 
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		pts, _, err := conn.ProtectedTags.ListProtectedTags(project, nil)
 		if err != nil {
@@ -153,7 +153,7 @@ func testAccCheckGitlabTagProtectionAttributes(pt *gitlab.ProtectedTag, want *te
 }
 
 func testAccCheckGitlabTagProtectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 	var project string
 	var tag string
 	for _, rs := range s.RootModule().Resources {
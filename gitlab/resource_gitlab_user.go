@@ -75,7 +75,7 @@ func resourceGitlabUserSetToState(d *schema.ResourceData, user *gitlab.User) {
 }
 
 func resourceGitlabUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	options := &gitlab.CreateUserOptions{
 		Email:            gitlab.String(d.Get("email").(string)),
 		Password:         gitlab.String(d.Get("password").(string)),
@@ -103,7 +103,7 @@ func resourceGitlabUserCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	log.Printf("[DEBUG] read gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
@@ -118,7 +118,7 @@ func resourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 
 	options := &gitlab.ModifyUserOptions{}
 
@@ -159,7 +159,7 @@ func resourceGitlabUserUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGitlabUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*gitlab.Client)
+	client := meta.(*gitlabMeta).client
 	log.Printf("[DEBUG] Delete gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
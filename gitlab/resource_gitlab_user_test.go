@@ -89,7 +89,7 @@ func testAccCheckGitlabUserExists(n string, user *gitlab.User) resource.TestChec
 		if userID == "" {
 			return fmt.Errorf("No user ID is set")
 		}
-		conn := testAccProvider.Meta().(*gitlab.Client)
+		conn := testAccProvider.Meta().(*gitlabMeta).client
 
 		id, _ := strconv.Atoi(userID)
 
@@ -129,7 +129,7 @@ func testAccCheckGitlabUserAttributes(user *gitlab.User, want *testAccGitlabUser
 }
 
 func testAccCheckGitlabUserDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*gitlab.Client)
+	conn := testAccProvider.Meta().(*gitlabMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_user" {
@@ -1,12 +1,17 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
+	"log"
+	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
+	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
 	gitlab "github.com/xanzy/go-gitlab"
 )
@@ -63,6 +68,46 @@ func validateDateFunc() schema.SchemaValidateFunc {
 	}
 }
 
+func validatePositiveDurationFunc() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (we []string, errors []error) {
+		value := v.(string)
+		d, e := time.ParseDuration(value)
+		if e != nil {
+			errors = append(errors, fmt.Errorf("%s is not a valid duration for argument %s, e.g. 90m or 24h", value, k))
+		} else if d <= 0 {
+			errors = append(errors, fmt.Errorf("%s must be a positive duration for argument %s, e.g. 90m or 24h", value, k))
+		}
+		return
+	}
+}
+
+// Bounds of a runner's maximum timeout, in seconds. The upper bound matches
+// the month GitLab allows at most for the timeout of a project, and is the
+// default of the runner_maximum_timeout_limit provider option.
+const (
+	minRunnerMaximumTimeout = 600
+	maxRunnerMaximumTimeout = 30 * 24 * 60 * 60
+)
+
+// runnerMaximumTimeoutLimit returns the largest maximum timeout accepted for
+// a runner by the provider.
+func runnerMaximumTimeoutLimit(meta *gitlabMeta) int {
+	if meta.config.RunnerMaximumTimeoutLimit > 0 {
+		return meta.config.RunnerMaximumTimeoutLimit
+	}
+	return maxRunnerMaximumTimeout
+}
+
+func validateRunnerMaximumTimeoutFunc(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (we []string, errors []error) {
+		value := v.(int)
+		if value < min || value > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d) seconds, got %d", k, min, max, value))
+		}
+		return
+	}
+}
+
 func validateURLFunc() schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (s []string, errors []error) {
 		value := v.(string)
@@ -139,6 +184,30 @@ func buildTwoPartID(a, b *string) string {
 	return fmt.Sprintf("%s:%s", *a, *b)
 }
 
+// twoPartIDEscaper URL-encodes the characters that would make the parts of
+// an ID ambiguous, while keeping IDs such as `group/project:key` readable.
+var twoPartIDEscaper = strings.NewReplacer("%", "%25", ":", "%3A")
+
+// twoPartIDUnescaper reverses twoPartIDEscaper, and only it.
+var twoPartIDUnescaper = strings.NewReplacer("%3A", ":", "%25", "%")
+
+// return the pieces of id `a:b` built by buildEncodedTwoPartID as a, b
+func parseEncodedTwoPartID(id string) (string, string, error) {
+	a, b, err := parseTwoPartID(id)
+	if err != nil {
+		return "", "", err
+	}
+
+	return twoPartIDUnescaper.Replace(a), twoPartIDUnescaper.Replace(b), nil
+}
+
+// format the strings into an id `a:b`, encoding the separator in a and b.
+// Resources already using buildTwoPartID keep it, so that their existing IDs
+// are still read the same way.
+func buildEncodedTwoPartID(a, b *string) string {
+	return fmt.Sprintf("%s:%s", twoPartIDEscaper.Replace(*a), twoPartIDEscaper.Replace(*b))
+}
+
 var accessLevelID = map[string]gitlab.AccessLevelValue{
 	"no one":     gitlab.NoPermissions,
 	"guest":      gitlab.GuestPermissions,
@@ -160,6 +229,17 @@ var accessLevel = map[gitlab.AccessLevelValue]string{
 	gitlab.OwnerPermission:       "owner",
 }
 
+func intSetToIntSlice(intSet *schema.Set) *[]int {
+	ret := []int{}
+	if intSet == nil {
+		return &ret
+	}
+	for _, v := range intSet.List() {
+		ret = append(ret, v.(int))
+	}
+	return &ret
+}
+
 func stringSetToStringSlice(stringSet *schema.Set) *[]string {
 	ret := []string{}
 	if stringSet == nil {
@@ -170,3 +250,247 @@ func stringSetToStringSlice(stringSet *schema.Set) *[]string {
 	}
 	return &ret
 }
+
+// listAllGitlabRunners pages through every runner of the instance. Access is
+// restricted to users with admin privileges.
+func listAllGitlabRunners(client *gitlab.Client, options *gitlab.ListRunnersOptions) ([]*gitlab.Runner, error) {
+	if options == nil {
+		options = &gitlab.ListRunnersOptions{}
+	}
+	options.PerPage = 100
+	options.Page = 1
+
+	var runners []*gitlab.Runner
+	for {
+		page, resp, err := client.Runners.ListAllRunners(options)
+		if err != nil {
+			return nil, wrapGitlabError(err, resp)
+		}
+		runners = append(runners, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return runners, nil
+}
+
+// listGitlabProjectRunners pages through every runner available to a project.
+func listGitlabProjectRunners(client *gitlab.Client, project interface{}, options *gitlab.ListProjectRunnersOptions) ([]*gitlab.Runner, error) {
+	if options == nil {
+		options = &gitlab.ListProjectRunnersOptions{}
+	}
+	options.PerPage = 100
+	options.Page = 1
+
+	var runners []*gitlab.Runner
+	for {
+		page, resp, err := client.Runners.ListProjectRunners(project, options)
+		if err != nil {
+			return nil, wrapGitlabError(err, resp)
+		}
+		runners = append(runners, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return runners, nil
+}
+
+// listGitlabGroupProjects pages through every project of a group.
+func listGitlabGroupProjects(client *gitlab.Client, group interface{}, options *gitlab.ListGroupProjectsOptions) ([]*gitlab.Project, error) {
+	if options == nil {
+		options = &gitlab.ListGroupProjectsOptions{}
+	}
+	options.PerPage = 100
+	options.Page = 1
+
+	var projects []*gitlab.Project
+	for {
+		page, resp, err := client.Groups.ListGroupProjects(group, options)
+		if err != nil {
+			return nil, wrapGitlabError(err, resp)
+		}
+		projects = append(projects, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return projects, nil
+}
+
+// isRetryableGitlabResponse reports whether the request failed with a status
+// worth retrying: rate limiting or a server error.
+func isRetryableGitlabResponse(resp *gitlab.Response) bool {
+	if resp == nil {
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// runnerHasTags reports whether every tag in want is present in tags.
+func runnerHasTags(tags []string, want []string) bool {
+	present := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		present[tag] = true
+	}
+	for _, tag := range want {
+		if !present[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// runnerContactedWithin reports whether the runner contacted GitLab within
+// window of now. Runners which never contacted GitLab never match.
+func runnerContactedWithin(runner *gitlab.RunnerDetails, window time.Duration, now time.Time) bool {
+	if runner.ContactedAt == nil {
+		return false
+	}
+	return now.Sub(*runner.ContactedAt) <= window
+}
+
+// wrapGitlabError annotates err with the ID GitLab assigned to the request, so
+// that failures can be traced in the GitLab logs.
+func wrapGitlabError(err error, resp *gitlab.Response) error {
+	if err == nil || resp == nil || resp.Response == nil {
+		return err
+	}
+
+	requestID := resp.Header.Get("X-Request-Id")
+	if requestID == "" {
+		return err
+	}
+
+	return fmt.Errorf("%s (request ID: %s)", err, requestID)
+}
+
+// redactedPattern matches the values of the token and registration_token
+// fields, whether formatted as JSON or as Go structs.
+var redactedPattern = regexp.MustCompile(`(?i)("?(?:registration_?)?token"?\s*[:=]\s*"?)[^"\s,}]+`)
+
+// redactSecrets masks the values of the token and registration_token fields
+// in s.
+func redactSecrets(s string) string {
+	return redactedPattern.ReplaceAllString(s, "${1}[REDACTED]")
+}
+
+// redactedJSON renders v, typically a go-gitlab options struct, as JSON with
+// its secrets masked, for use in log messages.
+func redactedJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("<%T>", v)
+	}
+	return redactSecrets(string(b))
+}
+
+// logLevels are the levels accepted by the log_level provider option, from
+// the most to the least verbose.
+var logLevels = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR"}
+
+// parseLogLevel returns the index in logLevels of the least severe level
+// logged by logRedacted. An empty level logs everything.
+func parseLogLevel(level string) (int, error) {
+	if level == "" {
+		return 0, nil
+	}
+	for i, l := range logLevels {
+		if strings.EqualFold(level, l) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %q, expected one of %s", level, strings.Join(logLevels, ", "))
+}
+
+// logLevelEnabled reports whether a message is logged when the least severe
+// level logged is minLevel, based on its [LEVEL] prefix. Messages without a
+// known level prefix are always logged.
+func logLevelEnabled(minLevel int, msg string) bool {
+	if !strings.HasPrefix(msg, "[") {
+		return true
+	}
+	end := strings.Index(msg, "]")
+	if end < 0 {
+		return true
+	}
+	for i, l := range logLevels {
+		if msg[1:end] == l {
+			return i >= minLevel
+		}
+	}
+	return true
+}
+
+// logRedacted works like log.Printf, but masks the values of the token and
+// registration_token fields in the message, and drops messages below the log
+// level of the provider the meta belongs to. Any log message that may include
+// runner options must go through it.
+func logRedacted(meta interface{}, format string, v ...interface{}) {
+	msg := fmt.Sprintf(format, v...)
+	if m, ok := meta.(*gitlabMeta); ok && !logLevelEnabled(m.config.minLogLevel, msg) {
+		return
+	}
+	log.Print(redactSecrets(msg))
+}
+
+// gitlabVersions caches the version of the GitLab instance each client talks
+// to, as it does not change during a run.
+var gitlabVersions sync.Map
+
+// gitlabVersionCache holds the version of a GitLab instance once it has been
+// successfully queried.
+type gitlabVersionCache struct {
+	mu      sync.Mutex
+	version *gitlab.Version
+}
+
+// gitlabInstanceVersion returns the version of the GitLab instance the client
+// talks to. It is only queried once per client, even when read concurrently,
+// while a failed query is retried by the next caller.
+func gitlabInstanceVersion(client *gitlab.Client) (*gitlab.Version, error) {
+	c, _ := gitlabVersions.LoadOrStore(client, &gitlabVersionCache{})
+	cache := c.(*gitlabVersionCache)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.version != nil {
+		return cache.version, nil
+	}
+
+	v, resp, err := client.Version.GetVersion()
+	if err != nil {
+		return nil, wrapGitlabError(err, resp)
+	}
+	cache.version = v
+
+	return v, nil
+}
+
+// gitlabVersionAtLeast reports whether the GitLab version v, as returned by
+// the version API (e.g. 12.0.3-ee), is at least min. The edition suffix is
+// not a pre-release and is ignored.
+func gitlabVersionAtLeast(v string, min string) (bool, error) {
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return false, fmt.Errorf("unable to parse GitLab version %q: %s", v, err)
+	}
+
+	segments := parsed.Segments()
+	core, err := version.NewVersion(fmt.Sprintf("%d.%d.%d", segments[0], segments[1], segments[2]))
+	if err != nil {
+		return false, err
+	}
+
+	return core.GreaterThanOrEqual(version.Must(version.NewVersion(min))), nil
+}
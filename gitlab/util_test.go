@@ -1,7 +1,17 @@
 package gitlab
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	gitlab "github.com/xanzy/go-gitlab"
 )
@@ -101,3 +111,471 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestTwoPartID(t *testing.T) {
+	cases := []struct {
+		A  string
+		B  string
+		ID string
+	}{
+		{
+			A:  "group/project",
+			B:  "feature/branch",
+			ID: "group/project:feature/branch",
+		},
+		// Existing IDs are not encoded, and are read as is
+		{
+			A:  "group/project",
+			B:  "release%3A1.0",
+			ID: "group/project:release%3A1.0",
+		},
+		{
+			A:  "group/project",
+			B:  "50%25off",
+			ID: "group/project:50%25off",
+		},
+		{
+			A:  "group/project",
+			B:  "release:1.0",
+			ID: "group/project:release:1.0",
+		},
+	}
+
+	for _, tc := range cases {
+		if id := buildTwoPartID(&tc.A, &tc.B); id != tc.ID {
+			t.Fatalf("got ID %q expected %q", id, tc.ID)
+		}
+
+		a, b, err := parseTwoPartID(tc.ID)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", tc.ID, err)
+		}
+		if a != tc.A || b != tc.B {
+			t.Fatalf("got %q and %q expected %q and %q", a, b, tc.A, tc.B)
+		}
+	}
+}
+
+func TestEncodedTwoPartID(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected string
+	}{
+		{
+			A:        "42",
+			B:        "key",
+			Expected: "42:key",
+		},
+		{
+			A:        "group/project",
+			B:        "feature/branch",
+			Expected: "group/project:feature/branch",
+		},
+		{
+			A:        "group/project",
+			B:        "release:1.0",
+			Expected: "group/project:release%3A1.0",
+		},
+		{
+			A:        "a:b/c",
+			B:        "100%",
+			Expected: "a%3Ab/c:100%25",
+		},
+	}
+
+	for _, tc := range cases {
+		id := buildEncodedTwoPartID(&tc.A, &tc.B)
+		if id != tc.Expected {
+			t.Fatalf("got ID %q expected %q", id, tc.Expected)
+		}
+
+		a, b, err := parseEncodedTwoPartID(id)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", id, err)
+		}
+		if a != tc.A || b != tc.B {
+			t.Fatalf("got %q and %q expected %q and %q", a, b, tc.A, tc.B)
+		}
+	}
+}
+
+func TestParseEncodedTwoPartID_unencoded(t *testing.T) {
+	cases := []struct {
+		ID string
+		A  string
+		B  string
+	}{
+		{
+			ID: "group%2Fproject:key",
+			A:  "group%2Fproject",
+			B:  "key",
+		},
+		{
+			ID: "group/project:50%off",
+			A:  "group/project",
+			B:  "50%off",
+		},
+		{
+			ID: "group/project:50%25off%3Anow",
+			A:  "group/project",
+			B:  "50%off:now",
+		},
+	}
+
+	for _, tc := range cases {
+		a, b, err := parseEncodedTwoPartID(tc.ID)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", tc.ID, err)
+		}
+		if a != tc.A || b != tc.B {
+			t.Fatalf("got %q and %q expected %q and %q", a, b, tc.A, tc.B)
+		}
+	}
+
+	if _, _, err := parseEncodedTwoPartID("no-separator"); err == nil {
+		t.Fatalf("expected an error for an ID without separator")
+	}
+}
+
+func TestWrapGitlabError(t *testing.T) {
+	err := errors.New("403 Forbidden")
+
+	if got := wrapGitlabError(nil, nil); got != nil {
+		t.Fatalf("got %v expected nil", got)
+	}
+
+	if got := wrapGitlabError(err, nil); got != err {
+		t.Fatalf("got %v expected %v", got, err)
+	}
+
+	resp := &gitlab.Response{Response: &http.Response{Header: http.Header{}}}
+	if got := wrapGitlabError(err, resp); got != err {
+		t.Fatalf("got %v expected %v", got, err)
+	}
+
+	resp.Header.Set("X-Request-Id", "c4b3a9d2-3e1f")
+	got := wrapGitlabError(err, resp)
+	if !strings.Contains(got.Error(), "c4b3a9d2-3e1f") {
+		t.Fatalf("expected request ID in %q", got)
+	}
+	if !strings.Contains(got.Error(), err.Error()) {
+		t.Fatalf("expected original error in %q", got)
+	}
+}
+
+func TestLogRedacted(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	token := "glrt-s3cr3t"
+	options := &gitlab.RegisterNewRunnerOptions{
+		Token:       gitlab.String(token),
+		Description: gitlab.String("builder"),
+	}
+
+	meta := &gitlabMeta{config: &Config{}}
+	logRedacted(meta, "[DEBUG] register gitlab runner %s", redactedJSON(options))
+	logRedacted(meta, "[DEBUG] register gitlab runner with token=%s", token)
+	logRedacted(meta, "[DEBUG] register gitlab runner with registration_token: %s", token)
+	logRedacted(meta, "[DEBUG] register gitlab runner %+v", struct{ Token string }{token})
+
+	out := buf.String()
+	if strings.Contains(out, token) {
+		t.Fatalf("token found in log output:\n%s", out)
+	}
+	if !strings.Contains(out, "builder") {
+		t.Fatalf("expected description in log output:\n%s", out)
+	}
+}
+
+func TestLogRedacted_logLevel(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	level, err := parseLogLevel("info")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	info := &gitlabMeta{config: &Config{LogLevel: "info", minLogLevel: level}}
+
+	logRedacted(info, "[DEBUG] enable gitlab runner %d on project %d", 42, 1)
+	logRedacted(info, "[INFO] Reading Gitlab runner %d projects", 42)
+	logRedacted(info, "[WARN] gitlab runner %d is no longer enabled on project %d", 42, 2)
+	logRedacted(info, "no level")
+
+	out := buf.String()
+	if strings.Contains(out, "[DEBUG]") {
+		t.Fatalf("debug line found in log output:\n%s", out)
+	}
+	for _, want := range []string{"[INFO]", "[WARN]", "no level"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in log output:\n%s", want, out)
+		}
+	}
+
+	// Another provider without a log level logs everything, while the
+	// first one keeps its own level.
+	level, err = parseLogLevel("")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	other := &gitlabMeta{config: &Config{minLogLevel: level}}
+
+	buf.Reset()
+	logRedacted(other, "[DEBUG] enable gitlab runner %d on project %d", 42, 1)
+	if !strings.Contains(buf.String(), "[DEBUG]") {
+		t.Fatalf("expected the debug line to be logged without a log level")
+	}
+
+	buf.Reset()
+	logRedacted(info, "[DEBUG] enable gitlab runner %d on project %d", 42, 1)
+	if buf.Len() != 0 {
+		t.Fatalf("debug line found in log output:\n%s", buf.String())
+	}
+
+	if _, err := parseLogLevel("verbose"); err == nil {
+		t.Fatalf("expected an error for an unknown log level")
+	}
+}
+
+func TestIsRetryableGitlabResponse(t *testing.T) {
+	cases := []struct {
+		StatusCode int
+		Expected   bool
+	}{
+		{StatusCode: 200, Expected: false},
+		{StatusCode: 403, Expected: false},
+		{StatusCode: 404, Expected: false},
+		{StatusCode: 429, Expected: true},
+		{StatusCode: 500, Expected: true},
+		{StatusCode: 503, Expected: true},
+	}
+
+	for _, tc := range cases {
+		resp := &gitlab.Response{Response: &http.Response{StatusCode: tc.StatusCode}}
+		if got := isRetryableGitlabResponse(resp); got != tc.Expected {
+			t.Fatalf("got %t expected %t for status %d", got, tc.Expected, tc.StatusCode)
+		}
+	}
+
+	if isRetryableGitlabResponse(nil) {
+		t.Fatalf("expected a missing response not to be retried")
+	}
+}
+
+func TestRunnerContactedWithin(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-5 * time.Minute)
+	stale := now.Add(-2 * time.Hour)
+
+	cases := []struct {
+		ContactedAt *time.Time
+		Expected    bool
+	}{
+		{
+			ContactedAt: &recent,
+			Expected:    true,
+		},
+		{
+			ContactedAt: &stale,
+			Expected:    false,
+		},
+		{
+			ContactedAt: nil,
+			Expected:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		runner := &gitlab.RunnerDetails{ContactedAt: tc.ContactedAt}
+		if got := runnerContactedWithin(runner, time.Hour, now); got != tc.Expected {
+			t.Fatalf("got %t expected %t for %v", got, tc.Expected, tc.ContactedAt)
+		}
+	}
+}
+
+func TestValidatePositiveDurationFunc(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "30s",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-5s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1 day",
+			ErrCount: 1,
+		},
+	}
+
+	validationFunc := validatePositiveDurationFunc()
+
+	for _, tc := range cases {
+		_, errors := validationFunc(tc.Value, "test_arg")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation error for %q", tc.ErrCount, tc.Value)
+		}
+	}
+}
+
+func TestValidateRunnerMaximumTimeoutFunc(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    300,
+			ErrCount: 1,
+		},
+		{
+			Value:    600,
+			ErrCount: 0,
+		},
+		{
+			Value:    3600,
+			ErrCount: 0,
+		},
+		{
+			Value:    maxRunnerMaximumTimeout,
+			ErrCount: 0,
+		},
+		{
+			Value:    maxRunnerMaximumTimeout + 1,
+			ErrCount: 1,
+		},
+	}
+
+	validationFunc := validateRunnerMaximumTimeoutFunc(minRunnerMaximumTimeout, maxRunnerMaximumTimeout)
+
+	for _, tc := range cases {
+		_, errors := validationFunc(tc.Value, "test_arg")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation error for %d", tc.ErrCount, tc.Value)
+		}
+	}
+}
+
+func TestGitlabVersionAtLeast(t *testing.T) {
+	cases := []struct {
+		Version  string
+		Min      string
+		Expected bool
+		Error    bool
+	}{
+		{
+			Version:  "12.0.3-ee",
+			Min:      "12.0",
+			Expected: true,
+		},
+		{
+			Version:  "11.11.8",
+			Min:      "12.0",
+			Expected: false,
+		},
+		{
+			Version:  "16.0.0-pre",
+			Min:      "16.0",
+			Expected: true,
+		},
+		{
+			Version: "unknown",
+			Min:     "16.0",
+			Error:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := gitlabVersionAtLeast(tc.Version, tc.Min)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("expected an error for %q", tc.Version)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", tc.Version, err)
+		}
+		if got != tc.Expected {
+			t.Fatalf("got %t expected %t for %q >= %q", got, tc.Expected, tc.Version, tc.Min)
+		}
+	}
+}
+
+func TestGitlabInstanceVersion_concurrent(t *testing.T) {
+	var calls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			time.Sleep(50 * time.Millisecond)
+			http.Error(w, `{"message": "502 Bad Gateway"}`, http.StatusBadGateway)
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(`{"version": "11.10.4-ee", "revision": "62c42d1"}`))
+	})
+	meta, teardown := testGitlabMeta(t, mux)
+	defer teardown()
+
+	// The first query fails, and is not cached
+	if _, err := gitlabInstanceVersion(meta.client); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := gitlabInstanceVersion(meta.client)
+			if err == nil && v.Version != "11.10.4-ee" {
+				err = fmt.Errorf("got version %q", v.Version)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("got %d version calls expected 2", got)
+	}
+
+	// Another client talks to its own instance
+	other, teardownOther := testGitlabMeta(t, mux)
+	defer teardownOther()
+
+	if _, err := gitlabInstanceVersion(other.client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Fatalf("got %d version calls expected 3", got)
+	}
+}